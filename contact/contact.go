@@ -4,7 +4,6 @@ package contact
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"io"
 	"net/http"
 	"net/url"
@@ -51,15 +50,11 @@ func (c *contact) DotCAAgreement(ctx context.Context) (map[string]string, error)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	ret := map[string]string{}
-	if err := json.Unmarshal(bytesResp, &ret); err != nil {
+	if err := c.core.Unmarshal(bytesResp, &ret); err != nil {
 		return nil, err
 	}
 
@@ -141,7 +136,7 @@ func (c *contact) AddExtraDetails(
 	}
 
 	if attributes == nil || domainKeys == nil || len(domainKeys) == 0 {
-		return errors.New("attributes and domain keys cannot be nil or empty")
+		return core.NewValidationError("attributes and domain keys cannot be nil or empty")
 	}
 
 	data := url.Values{}
@@ -173,14 +168,10 @@ func (c *contact) AddExtraDetails(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
-	boolResult, err := strconv.ParseBool(string(bytesResp))
+	boolResult, err := core.ParseBool(bytesResp)
 	if err != nil {
 		return err
 	}
@@ -197,7 +188,7 @@ func (c *contact) ValidateRegistrant(ctx context.Context, contactID string, elig
 	}
 
 	if len(eligibilities) == 0 {
-		return nil, errors.New("eligibilities must not empty")
+		return nil, core.NewValidationError("eligibilities must not empty")
 	}
 
 	data := url.Values{}
@@ -228,15 +219,11 @@ func (c *contact) ValidateRegistrant(ctx context.Context, contactID string, elig
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	validation := RegistrantValidation{}
-	if err := json.Unmarshal(bytesResp, &validation); err != nil {
+	if err := c.core.Unmarshal(bytesResp, &validation); err != nil {
 		return nil, err
 	}
 
@@ -246,7 +233,7 @@ func (c *contact) ValidateRegistrant(ctx context.Context, contactID string, elig
 //nolint:funlen
 func (c *contact) Default(ctx context.Context, customerID string, types []Type) (map[string]Detail, error) {
 	if len(types) == 0 {
-		return nil, errors.New("contact types must not empty")
+		return nil, core.NewValidationError("contact types must not empty")
 	}
 	if !core.RgxNumber.MatchString(customerID) {
 		return nil, core.ErrRcInvalidCredential
@@ -270,11 +257,7 @@ func (c *contact) Default(ctx context.Context, customerID string, types []Type)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	replacer := strings.NewReplacer("contact.", "", "entity.", "")
@@ -282,17 +265,17 @@ func (c *contact) Default(ctx context.Context, customerID string, types []Type)
 	bytesResp = []byte(strResp)
 
 	exoSkeleton := map[string]core.JSONBytes{}
-	if err := json.Unmarshal(bytesResp, &exoSkeleton); err != nil {
+	if err := c.core.Unmarshal(bytesResp, &exoSkeleton); err != nil {
 		return nil, err
 	}
 	if len(exoSkeleton) == 0 {
-		return nil, errors.New("failed while extract exoskeleton")
+		return nil, &core.APIError{StatusCode: resp.StatusCode, Message: "failed while extract exoskeleton"}
 	}
 
 	contacts := map[string]core.JSONBytes{}
 	for _, elem := range exoSkeleton {
 		bytesResp = []byte(elem)
-		if err := json.Unmarshal(bytesResp, &contacts); err != nil {
+		if err := c.core.Unmarshal(bytesResp, &contacts); err != nil {
 			return nil, err
 		}
 	}
@@ -300,6 +283,7 @@ func (c *contact) Default(ctx context.Context, customerID string, types []Type)
 	wg := sync.WaitGroup{}
 	rwMutex := sync.RWMutex{}
 	defaultContacts := map[string]Detail{}
+	var decodeErr error
 
 	for k, v := range contacts {
 		wg.Add(1)
@@ -311,17 +295,23 @@ func (c *contact) Default(ctx context.Context, customerID string, types []Type)
 				return
 			default:
 				ctc := Detail{}
-				if err := json.Unmarshal(bytesValue, &ctc); err != nil {
+				err := c.core.Unmarshal(bytesValue, &ctc)
+				rwMutex.Lock()
+				defer rwMutex.Unlock()
+				if err != nil {
+					decodeErr = err
 					return
 				}
-				rwMutex.Lock()
 				defaultContacts[strings.TrimSuffix(key, "ContactDetails")] = ctc
-				rwMutex.Unlock()
 			}
 		}(k, v)
 	}
 	wg.Wait()
 
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+
 	return defaultContacts, nil
 }
 
@@ -331,7 +321,7 @@ func (c *contact) SetDefault(
 	types []Type,
 ) error {
 	if len(types) == 0 {
-		return errors.New("contact types must not empty")
+		return core.NewValidationError("contact types must not empty")
 	}
 	if !core.RgxNumber.MatchString(customerID) || !core.RgxNumber.MatchString(regContactID) ||
 		!core.RgxNumber.MatchString(adminContactID) || !core.RgxNumber.MatchString(techContactID) ||
@@ -362,11 +352,7 @@ func (c *contact) SetDefault(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return nil
@@ -374,11 +360,11 @@ func (c *contact) SetDefault(
 
 func (c *contact) Search(ctx context.Context, criteria Criteria, offset, limit uint16) (*SearchResult, error) {
 	if offset <= 0 || limit <= 0 {
-		return nil, errors.New("offset or limit must greater than zero")
+		return nil, core.NewValidationError("offset or limit must greater than zero")
 	}
 
 	if err := validator.New().Struct(criteria); err != nil {
-		return nil, err
+		return nil, &core.ValidationError{Err: err}
 	}
 
 	data, err := criteria.URLValues()
@@ -400,11 +386,7 @@ func (c *contact) Search(ctx context.Context, criteria Criteria, offset, limit u
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	replacer := strings.NewReplacer("entity.", "", "contact.", "")
@@ -412,7 +394,7 @@ func (c *contact) Search(ctx context.Context, criteria Criteria, offset, limit u
 
 	var buffer map[string]core.JSONBytes
 	if err := json.Unmarshal([]byte(strResp), &buffer); err != nil {
-		return nil, err
+		return nil, core.NewDecodeError(err)
 	}
 
 	var dataBuffers []Detail
@@ -426,7 +408,7 @@ func (c *contact) Search(ctx context.Context, criteria Criteria, offset, limit u
 				numMatched = 0
 			}
 		case key == "result":
-			if err := json.Unmarshal(dataBytes, &dataBuffers); err != nil {
+			if err := c.core.Unmarshal(dataBytes, &dataBuffers); err != nil {
 				return nil, err
 			}
 		}
@@ -457,15 +439,11 @@ func (c *contact) Delete(ctx context.Context, contactID string) (*Action, error)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	ret := new(Action)
-	if err := json.Unmarshal(bytesResp, ret); err != nil {
+	if err := c.core.Unmarshal(bytesResp, ret); err != nil {
 		return nil, err
 	}
 
@@ -492,15 +470,11 @@ func (c *contact) Details(ctx context.Context, contactID string) (*Detail, error
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	ret := new(Detail)
-	if err := json.Unmarshal(bytesResp, ret); err != nil {
+	if err := c.core.Unmarshal(bytesResp, ret); err != nil {
 		return nil, err
 	}
 
@@ -509,7 +483,7 @@ func (c *contact) Details(ctx context.Context, contactID string) (*Detail, error
 
 func (c *contact) Add(ctx context.Context, details *Detail, attributes core.EntityAttributes) error {
 	if details == nil {
-		return errors.New("detail must not nil")
+		return core.NewValidationError("detail must not nil")
 	}
 
 	data, err := details.URLValues()
@@ -533,11 +507,7 @@ func (c *contact) Add(ctx context.Context, details *Detail, attributes core.Enti
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	details.ID = string(bytesResp)
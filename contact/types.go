@@ -1,7 +1,6 @@
 package contact
 
 import (
-	"errors"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -116,7 +115,7 @@ const (
 
 func (c *Criteria) URLValues() (url.Values, error) {
 	if err := validator.New().Struct(c); err != nil {
-		return nil, err
+		return nil, &core.ValidationError{Err: err}
 	}
 
 	wg := sync.WaitGroup{}
@@ -203,7 +202,7 @@ func (c *Criteria) URLValues() (url.Values, error) {
 func (c *Detail) URLValues() (*url.Values, error) {
 	v := validator.New()
 	if err := v.Struct(c); err != nil {
-		return nil, err
+		return nil, &core.ValidationError{Err: err}
 	}
 
 	valueCurrent := reflect.ValueOf(c)
@@ -219,7 +218,7 @@ func (c *Detail) URLValues() (*url.Values, error) {
 		}
 		if vFieldCurrent.IsZero() {
 			if !strings.HasSuffix(tagFieldCurrent, ",optional") {
-				return nil, errors.New(strings.ToLower(tFieldCurrent.Name) + " must not empty")
+				return nil, core.NewValidationError(strings.ToLower(tFieldCurrent.Name) + " must not empty")
 			}
 			continue
 		}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BatchConcurrency is the maximum number of requests in flight for a batch operation.
+const BatchConcurrency = 8
+
+// BatchError holds the errors of a batch operation keyed by the item which failed.
+type BatchError map[string]error
+
+func (e BatchError) Error() string {
+	keys := make([]string, 0, len(e))
+	for key := range e {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	msgs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		msgs = append(msgs, key+": "+e[key].Error())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (e BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// RunBatch calls fn for every key concurrently, with at most BatchConcurrency calls in flight.
+// The results of the calls which succeeded are returned along with a BatchError keyed by the keys
+// which failed, or a nil error when none did.
+func RunBatch[T any](ctx context.Context, keys []string, fn func(ctx context.Context, key string) (T, error)) (map[string]T, error) {
+	wg := sync.WaitGroup{}
+	rwMutex := sync.RWMutex{}
+	semaphore := make(chan struct{}, BatchConcurrency)
+
+	results := make(map[string]T, len(keys))
+	errs := BatchError{}
+
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				rwMutex.Lock()
+				errs[key] = ctx.Err()
+				rwMutex.Unlock()
+				return
+			}
+
+			res, err := fn(ctx, key)
+			rwMutex.Lock()
+			defer rwMutex.Unlock()
+			if err != nil {
+				errs[key] = err
+				return
+			}
+			results[key] = res
+		}(key)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return results, errs
+	}
+
+	return results, nil
+}
@@ -0,0 +1,30 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBatch(t *testing.T) {
+	results, err := RunBatch(context.Background(), []string{"1", "2", "3"}, func(_ context.Context, key string) (string, error) {
+		if key == "2" {
+			return "", errors.New("not found")
+		}
+		return "order " + key, nil
+	})
+	require.Equal(t, map[string]string{"1": "order 1", "3": "order 3"}, results)
+
+	var batchErr BatchError
+	require.ErrorAs(t, err, &batchErr)
+	require.Len(t, batchErr, 1)
+	require.EqualError(t, batchErr["2"], "not found")
+
+	results, err = RunBatch(context.Background(), []string{"1"}, func(_ context.Context, key string) (string, error) {
+		return key, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+}
@@ -5,8 +5,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -29,6 +29,8 @@ type Config struct {
 	ResellerID   string
 	APIKey       string
 	IsProduction bool
+	// StrictDecoding makes Core.Unmarshal fail on response fields unknown to the target struct.
+	StrictDecoding bool
 }
 
 type core struct {
@@ -48,9 +50,14 @@ type Criteria struct {
 	TimeCreationEnd   time.Time `validate:"omitempty" query:"creation-date-end,omitempty"`
 }
 
+type resellerKey struct{}
+
 type Core interface {
+	// CallAPI returns an APIError when a successful response reports a failed status. The response
+	// is then returned too, with its body buffered, for callers that decode failed results.
 	CallAPI(ctx context.Context, method, namespace, apiName string, data url.Values) (*http.Response, error)
 	IsProduction() bool
+	Unmarshal(data []byte, v any) error
 }
 
 // Const for status.
@@ -80,21 +87,56 @@ var (
 	RgxEmail  = regexp.MustCompile("^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$") //nolint:lll
 	RgxNumber = regexp.MustCompile(`^\d+$`)
 
-	ErrRcAPIUnsupportedMethod = errors.New("unsupported http method")
-	ErrRcOperationFailed      = errors.New("operation failed")
-	ErrRcInvalidCredential    = errors.New("invalid credential")
+	// resellerScopedAPIs are the calls on which reseller-id selects the sub-reseller acted upon.
+	// LogicBoxes lets no parent reseller create customers or orders for a sub-reseller, and on
+	// searches reseller-id is a filter, so other calls are left unscoped.
+	resellerScopedAPIs = map[string]bool{
+		"billing/reseller-balance":     true,
+		"products/reseller-price":      true,
+		"products/reseller-cost-price": true,
+	}
+
+	ErrRcAPIUnsupportedMethod error = NewValidationError("unsupported http method")
+	ErrRcOperationFailed      error = &APIError{Status: "Failed", Message: "operation failed"}
+	ErrRcInvalidCredential    error = NewValidationError("invalid credential")
 )
 
 func (c *core) IsProduction() bool {
 	return c.cfg.IsProduction
 }
 
+// Unmarshal decodes a response, rejecting unknown fields when StrictDecoding is set.
+// Decoding errors are returned as an APIError wrapping them.
+func (c *core) Unmarshal(data []byte, v any) error {
+	if err := c.unmarshal(data, v); err != nil {
+		return NewDecodeError(err)
+	}
+
+	return nil
+}
+
+func (c *core) unmarshal(data []byte, v any) error {
+	if !c.cfg.StrictDecoding {
+		return json.Unmarshal(data, v)
+	}
+
+	// The decoder stops after the first value, so malformed data is left to json.Unmarshal to report.
+	if !json.Valid(data) {
+		return json.Unmarshal(data, v)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(v)
+}
+
 // URLValues godoc
 //
 //nolint:gocognit
 func (c Criteria) URLValues() (url.Values, error) {
 	if err := validator.New().Struct(c); err != nil {
-		return url.Values{}, err
+		return url.Values{}, &ValidationError{Err: err}
 	}
 
 	wg := sync.WaitGroup{}
@@ -166,11 +208,43 @@ func PrintResponse(data []byte) error {
 	return nil
 }
 
+// WithReseller scopes the calls made with the returned context to the sub-reseller, by sending its ID
+// as the reseller-id parameter of the calls in resellerScopedAPIs which do not set one. These are the
+// reseller balance, read by general.ResellerCurrency, and the reseller prices of the pricing package.
+func WithReseller(ctx context.Context, resellerID string) context.Context {
+	return context.WithValue(ctx, resellerKey{}, resellerID)
+}
+
+// ResellerFrom returns the sub-reseller ID set by WithReseller.
+func ResellerFrom(ctx context.Context) (string, bool) {
+	resellerID, ok := ctx.Value(resellerKey{}).(string)
+	return resellerID, ok
+}
+
+func scopeReseller(ctx context.Context, api string, data url.Values) error {
+	resellerID, ok := ResellerFrom(ctx)
+	if !ok {
+		return nil
+	}
+	if !RgxNumber.MatchString(resellerID) {
+		return ErrRcInvalidCredential
+	}
+	if resellerScopedAPIs[api] && !data.Has("reseller-id") {
+		data.Set("reseller-id", resellerID)
+	}
+
+	return nil
+}
+
 func (c *core) CallAPI(ctx context.Context, method, namespace, apiName string, data url.Values) (*http.Response, error) {
 	urlPath := host[c.cfg.IsProduction] + "/" + namespace + "/" + apiName + ".json"
 	data.Add("auth-userid", c.cfg.ResellerID)
 	data.Add("api-key", c.cfg.APIKey)
 
+	if err := scopeReseller(ctx, namespace+"/"+apiName, data); err != nil {
+		return nil, err
+	}
+
 	if method != http.MethodGet && method != http.MethodPost {
 		return nil, ErrRcAPIUnsupportedMethod
 	}
@@ -180,17 +254,65 @@ func (c *core) CallAPI(ctx context.Context, method, namespace, apiName string, d
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	return c.client.Do(req)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	return checkStatus(resp)
+}
+
+// checkStatus returns an APIError when a successful response reports a failed status in its body,
+// as LogicBoxes does on some operations. The body is buffered and the response is returned along
+// with the error, so calls whose failures carry a result, such as the DENIC recheck, can decode it.
+func checkStatus(resp *http.Response) (*http.Response, error) {
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(bytesResp))
+	if isFailedStatus(bytesResp) {
+		return resp, NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	return resp, nil
+}
+
+func isFailedStatus(body []byte) bool {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || body[0] != '{' {
+		return false
+	}
+
+	var statusResponse struct {
+		Status json.RawMessage `json:"status"`
+	}
+	if err := json.Unmarshal(body, &statusResponse); err != nil {
+		return false
+	}
+
+	var status string
+	if err := json.Unmarshal(statusResponse.Status, &status); err != nil {
+		return false
+	}
+
+	return strings.EqualFold(status, "ERROR") || strings.EqualFold(status, "Failed")
 }
 
 func New(cfg Config, client *http.Client) Core {
-	var c *http.Client
 	if client == nil {
-		c = http.DefaultClient
+		client = http.DefaultClient
 	}
 
 	return &core{
 		cfg:    cfg,
-		client: c,
+		client: client,
 	}
 }
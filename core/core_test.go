@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithReseller(t *testing.T) {
+	ctx := WithReseller(context.Background(), "12345")
+	resellerID, ok := ResellerFrom(ctx)
+	require.True(t, ok)
+	require.Equal(t, "12345", resellerID)
+
+	_, ok = ResellerFrom(context.Background())
+	require.False(t, ok)
+
+	_, err := New(Config{}, nil).CallAPI(WithReseller(context.Background(), "reseller"), http.MethodGet, "customers", "details", url.Values{})
+	require.ErrorIs(t, err, ErrRcInvalidCredential)
+}
+
+func TestScopeReseller(t *testing.T) {
+	ctx := WithReseller(context.Background(), "12345")
+
+	data := url.Values{}
+	require.NoError(t, scopeReseller(ctx, "billing/reseller-balance", data))
+	require.Equal(t, "12345", data.Get("reseller-id"))
+
+	data = url.Values{"reseller-id": {"678"}}
+	require.NoError(t, scopeReseller(ctx, "products/reseller-price", data))
+	require.Equal(t, "678", data.Get("reseller-id"))
+
+	data = url.Values{}
+	require.NoError(t, scopeReseller(ctx, "resellers/details", data))
+	require.False(t, data.Has("reseller-id"))
+
+	data = url.Values{}
+	require.NoError(t, scopeReseller(ctx, "domains/search", data))
+	require.False(t, data.Has("reseller-id"))
+}
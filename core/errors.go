@@ -0,0 +1,76 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// APIError is returned when LogicBoxes rejects or fails a request, or answers it with a body which
+// cannot be decoded. Err then holds the decoding error.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Message    string
+	Err        error
+}
+
+// ValidationError is returned when a request is rejected before being sent to LogicBoxes.
+type ValidationError struct {
+	Err error
+}
+
+func (e *APIError) Error() string {
+	return strings.ToLower(e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// NewAPIError builds an APIError from the body of a failed response.
+func NewAPIError(statusCode int, body []byte) *APIError {
+	errResponse := JSONStatusResponse{}
+	if err := json.Unmarshal(body, &errResponse); err != nil || errResponse.Message == "" {
+		errResponse.Message = strings.TrimSpace(string(body))
+	}
+
+	return &APIError{
+		StatusCode: statusCode,
+		Status:     errResponse.Status,
+		Message:    errResponse.Message,
+	}
+}
+
+// NewDecodeError builds an APIError from the error decoding a successful response.
+func NewDecodeError(err error) *APIError {
+	return &APIError{
+		StatusCode: http.StatusOK,
+		Message:    "malformed response: " + err.Error(),
+		Err:        err,
+	}
+}
+
+// ParseBool decodes the true or false body returned by some operations.
+func ParseBool(body []byte) (bool, error) {
+	result, err := strconv.ParseBool(strings.TrimSpace(string(body)))
+	if err != nil {
+		return false, NewDecodeError(err)
+	}
+
+	return result, nil
+}
+
+func NewValidationError(msg string) *ValidationError {
+	return &ValidationError{Err: errors.New(msg)}
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIError(t *testing.T) {
+	err := error(NewAPIError(http.StatusInternalServerError, []byte(`{"status":"ERROR","message":"Invalid Order ID"}`)))
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+	require.Equal(t, "ERROR", apiErr.Status)
+	require.Equal(t, "invalid order id", err.Error())
+
+	apiErr = NewAPIError(http.StatusBadGateway, []byte("Bad Gateway\n"))
+	require.Equal(t, "Bad Gateway", apiErr.Message)
+}
+
+func TestValidationError(t *testing.T) {
+	var validationErr *ValidationError
+	require.ErrorAs(t, ErrRcInvalidCredential, &validationErr)
+	require.False(t, errors.As(ErrRcInvalidCredential, new(*APIError)))
+	require.ErrorAs(t, ErrRcOperationFailed, new(*APIError))
+}
+
+func TestCheckStatus(t *testing.T) {
+	resp, err := checkStatus(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"status":"ERROR","message":"Domain forwarding is not enabled"}`)),
+	})
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusOK, apiErr.StatusCode)
+	require.Equal(t, "domain forwarding is not enabled", apiErr.Error())
+
+	require.NotNil(t, resp)
+	bytesResp, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"status":"ERROR","message":"Domain forwarding is not enabled"}`, string(bytesResp))
+
+	resp, err = checkStatus(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"status":"Success","actionstatus":"Failed"}`)),
+	})
+	require.NoError(t, err)
+
+	bytesResp, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"status":"Success","actionstatus":"Failed"}`, string(bytesResp))
+
+	require.False(t, isFailedStatus([]byte(`[{"status":"ERROR"}]`)))
+	require.False(t, isFailedStatus([]byte(`true`)))
+}
+
+func TestParseBool(t *testing.T) {
+	result, err := ParseBool([]byte(" true\n"))
+	require.NoError(t, err)
+	require.True(t, result)
+
+	_, err = ParseBool([]byte(`<html>maintenance</html>`))
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusOK, apiErr.StatusCode)
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+
+	err = New(Config{}, nil).Unmarshal([]byte(`{"status":`), &JSONStatusResponse{})
+	require.ErrorAs(t, err, &apiErr)
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -44,13 +45,49 @@ func (j JSONFloat) ToFloat64() float64 {
 	return float64(j)
 }
 
+// timeLayouts are the layouts of the string dates returned by LogicBoxes.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999-07",
+	"2006-01-02 15:04:05.999999-07:00",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+	"2006-01-02",
+}
+
+// parseTime parses either epoch seconds (or milliseconds) or a string date in one of timeLayouts.
+// An empty value yields the zero time.
+func parseTime(b []byte) (time.Time, error) {
+	s := strings.TrimSpace(strings.Trim(string(b), "\""))
+	if s == "" || s == "null" {
+		return time.Time{}, nil
+	}
+
+	if RgxNumber.MatchString(s) {
+		epoch, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if len(s) >= 13 {
+			return time.UnixMilli(epoch), nil
+		}
+		return time.Unix(epoch, 0), nil
+	}
+
+	for _, layout := range timeLayouts {
+		if tValue, err := time.Parse(layout, s); err == nil {
+			return tValue, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported time format: %q", s)
+}
+
 func (j *JSONTime) UnmarshalJSON(b []byte) error {
-	s := strings.Trim(string(b), "\"")
-	tValue, err := strconv.ParseInt(s, 10, 64)
+	tValue, err := parseTime(b)
 	if err != nil {
 		return err
 	}
-	*j = JSONTime(time.Unix(tValue, 0))
+	*j = JSONTime(tValue)
 	return nil
 }
 
@@ -58,9 +95,12 @@ func (j JSONTime) ToTime() time.Time {
 	return time.Time(j)
 }
 
+func (j JSONTime) Time() time.Time {
+	return time.Time(j)
+}
+
 func (j *JSONTimestamp) UnmarshalJSON(b []byte) error {
-	s := strings.Trim(string(b), "\"")
-	tValue, err := time.Parse("2006-01-02 15:04:05.999999-07", s)
+	tValue, err := parseTime(b)
 	if err != nil {
 		return err
 	}
@@ -72,6 +112,10 @@ func (j JSONTimestamp) ToTime() time.Time {
 	return time.Time(j)
 }
 
+func (j JSONTimestamp) Time() time.Time {
+	return time.Time(j)
+}
+
 func (j *JSONUint16) UnmarshalJSON(b []byte) error {
 	s := strings.Trim(string(b), "\"")
 	tValue, err := strconv.ParseInt(s, 10, 16)
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONTimeUnmarshal(t *testing.T) {
+	cases := map[string]time.Time{
+		`"1700000000"`:                    time.Unix(1700000000, 0),
+		`1700000000`:                      time.Unix(1700000000, 0),
+		`"1700000000123"`:                 time.UnixMilli(1700000000123),
+		`"2023-11-14 22:13:20.123456+00"`: time.Date(2023, 11, 14, 22, 13, 20, 123456000, time.UTC),
+		`"2023-11-14 22:13:20"`:           time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC),
+		`"2023-11-14"`:                    time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC),
+		`""`:                              {},
+	}
+
+	for input, expected := range cases {
+		var jTime JSONTime
+		require.NoError(t, json.Unmarshal([]byte(input), &jTime), input)
+		require.True(t, expected.Equal(jTime.Time()), input)
+
+		var jTimestamp JSONTimestamp
+		require.NoError(t, json.Unmarshal([]byte(input), &jTimestamp), input)
+		require.True(t, expected.Equal(jTimestamp.Time()), input)
+	}
+}
+
+func TestJSONTimeUnmarshalInvalid(t *testing.T) {
+	var jTime JSONTime
+	require.Error(t, json.Unmarshal([]byte(`"yesterday"`), &jTime))
+}
+
+func TestUnmarshalStrictDecoding(t *testing.T) {
+	var res JSONStatusResponse
+	data := []byte(`{"status":"Success","message":"ok","eaqid":"1"}`)
+
+	require.NoError(t, New(Config{}, nil).Unmarshal(data, &res))
+	require.Equal(t, "Success", res.Status)
+
+	err := New(Config{StrictDecoding: true}, nil).Unmarshal(data, &res)
+	require.ErrorContains(t, err, "unknown field")
+
+	err = New(Config{StrictDecoding: true}, nil).Unmarshal([]byte(`{"status":"Success"} {}`), &res)
+	var syntaxErr *json.SyntaxError
+	require.ErrorAs(t, err, &syntaxErr)
+}
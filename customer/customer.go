@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -19,12 +20,17 @@ type customer struct {
 	core core.Core
 }
 
+// ErrAlreadyVerified is returned when resending the verification email of a verified customer.
+var ErrAlreadyVerified = errors.New("customer is already verified")
+
 type Customer interface {
 	SignUp(ctx context.Context, regForm *SignUpForm) error
 	ChangePassword(ctx context.Context, customerID, newPassword string) error
 	Details(ctx context.Context, customerIDOrEmail string) (*Detail, error)
+	TaxIDs(ctx context.Context, customerID string) (TaxInfo, error)
 	Delete(ctx context.Context, customerID string) error
 	ForgotPassword(ctx context.Context, username string) error
+	ResendVerificationEmail(ctx context.Context, customerID string) error
 	Suspension(ctx context.Context, toggle bool, customerID, reason string) error
 	Search(ctx context.Context, criteria Criteria, offset, limit uint16) (*SearchResult, error)
 	Modify(ctx context.Context, customerIDOrEmail string, modification Detail) error
@@ -32,6 +38,7 @@ type Customer interface {
 	VerifyOTP(ctx context.Context, customerID, otp string, authType core.AuthType) (bool, error)
 	GenerateToken(ctx context.Context, username, password, ip string) (string, error)
 	GenerateLoginToken(ctx context.Context, customerID, ip, dashboardBaseURL string) (LoginToken, error)
+	GenerateLoginTokenWithOptions(ctx context.Context, customerID, ip string, opts LoginTokenOptions) (LoginToken, error)
 	Authenticate(ctx context.Context, username, password string) (*Detail, *ErrorAuthentication)
 	AuthenticateToken(ctx context.Context, token string, withHistory bool) (*Detail, error)
 }
@@ -57,15 +64,11 @@ func (c *customer) AuthenticateToken(ctx context.Context, token string, withHist
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	ret := new(Detail)
-	if err := json.Unmarshal(bytesResp, ret); err != nil {
+	if err := c.core.Unmarshal(bytesResp, ret); err != nil {
 		return nil, err
 	}
 
@@ -73,17 +76,28 @@ func (c *customer) AuthenticateToken(ctx context.Context, token string, withHist
 }
 
 func (c *customer) GenerateLoginToken(ctx context.Context, customerID, ip, dashboardBaseURL string) (LoginToken, error) {
+	return c.GenerateLoginTokenWithOptions(ctx, customerID, ip, LoginTokenOptions{
+		DashboardBaseURL: dashboardBaseURL,
+	})
+}
+
+func (c *customer) GenerateLoginTokenWithOptions(ctx context.Context, customerID, ip string, opts LoginTokenOptions) (LoginToken, error) {
 	if !core.RgxNumber.MatchString(customerID) {
-		return nil, errors.New("invalid format on customerid")
+		return nil, core.NewValidationError("invalid format on customerid")
 	}
 
 	baseURL := "http://demo.myorderbox.com"
 	if c.core.IsProduction() {
 		rgxURL := regexp.MustCompile(`^https?://.*$`)
-		if !rgxURL.MatchString(dashboardBaseURL) {
-			return nil, errors.New("dashboard's baseurl is required in production mode")
+		if !rgxURL.MatchString(opts.DashboardBaseURL) {
+			return nil, core.NewValidationError("dashboard's baseurl is required in production mode")
 		}
-		baseURL = dashboardBaseURL
+		baseURL = opts.DashboardBaseURL
+	}
+
+	role := opts.Role
+	if role == "" {
+		role = LoginRoleCustomer
 	}
 
 	data := url.Values{}
@@ -102,16 +116,14 @@ func (c *customer) GenerateLoginToken(ctx context.Context, customerID, ip, dashb
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	token := &loginToken{
 		baseURL: baseURL,
 		token:   string(bytesResp),
+		role:    role,
+		params:  opts.Params,
 	}
 
 	return token, nil
@@ -119,11 +131,11 @@ func (c *customer) GenerateLoginToken(ctx context.Context, customerID, ip, dashb
 
 func (c *customer) GenerateToken(ctx context.Context, username, password, ip string) (string, error) {
 	if !matchPasswordWithPattern(password, true) {
-		return "", errors.New("invalid format on password")
+		return "", core.NewValidationError("invalid format on password")
 	}
 
 	if !core.RgxEmail.MatchString(username) {
-		return "", errors.New("invalid format on email")
+		return "", core.NewValidationError("invalid format on email")
 	}
 
 	data := url.Values{}
@@ -143,11 +155,7 @@ func (c *customer) GenerateToken(ctx context.Context, username, password, ip str
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return "", err
-		}
-		return "", errors.New(strings.ToLower(errResponse.Message))
+		return "", core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return string(bytesResp), nil
@@ -184,7 +192,7 @@ func (c *customer) Authenticate(ctx context.Context, username, password string)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		err := json.Unmarshal(bytesResp, errAuth)
+		err := c.core.Unmarshal(bytesResp, errAuth)
 		if err != nil {
 			errAuth.Message = err.Error()
 			return nil, errAuth
@@ -193,7 +201,7 @@ func (c *customer) Authenticate(ctx context.Context, username, password string)
 	}
 
 	ret := new(Detail)
-	if err := json.Unmarshal(bytesResp, ret); err != nil {
+	if err := c.core.Unmarshal(bytesResp, ret); err != nil {
 		errAuth.Message = err.Error()
 		return nil, errAuth
 	}
@@ -223,15 +231,10 @@ func (c *customer) VerifyOTP(ctx context.Context, customerID, otp string, authTy
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return false, err
-		}
-		return false, errors.New(strings.ToLower(errResponse.Message))
+		return false, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
-	return strconv.ParseBool(string(bytesResp))
+	return core.ParseBool(bytesResp)
 }
 
 func (c *customer) GenerateOTP(ctx context.Context, customerID string) error {
@@ -251,15 +254,10 @@ func (c *customer) GenerateOTP(ctx context.Context, customerID string) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
-	boolResult, err := strconv.ParseBool(string(bytesResp))
+	boolResult, err := core.ParseBool(bytesResp)
 	if err != nil {
 		return err
 	}
@@ -273,16 +271,16 @@ func (c *customer) GenerateOTP(ctx context.Context, customerID string) error {
 func (c *customer) Modify(ctx context.Context, customerIDOrEmail string, modification Detail) error {
 	customerBefore, err := c.Details(ctx, customerIDOrEmail)
 	if err != nil {
-		return nil
+		return err
 	}
 
 	if err := modification.mergePrevious(customerBefore); err != nil {
-		return nil
+		return err
 	}
 
 	data, err := modification.URLValues()
 	if err != nil {
-		return nil
+		return err
 	}
 	data.Add("customer-id", customerBefore.ID)
 
@@ -298,15 +296,10 @@ func (c *customer) Modify(ctx context.Context, customerIDOrEmail string, modific
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
-	boolResult, err := strconv.ParseBool(string(bytesResp))
+	boolResult, err := core.ParseBool(bytesResp)
 	if err != nil {
 		return err
 	}
@@ -319,10 +312,10 @@ func (c *customer) Modify(ctx context.Context, customerIDOrEmail string, modific
 
 func (c *customer) Search(ctx context.Context, criteria Criteria, offset, limit uint16) (*SearchResult, error) {
 	if limit < 10 || limit > 500 {
-		return nil, errors.New("limit must be in range of 10 to 500")
+		return nil, core.NewValidationError("limit must be in range of 10 to 500")
 	}
 	if offset <= 0 {
-		return nil, errors.New("offset must greater than 0")
+		return nil, core.NewValidationError("offset must greater than 0")
 	}
 
 	data, err := criteria.URLValues()
@@ -344,12 +337,7 @@ func (c *customer) Search(ctx context.Context, criteria Criteria, offset, limit
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	replacer := strings.NewReplacer("customer.", "")
@@ -357,7 +345,7 @@ func (c *customer) Search(ctx context.Context, criteria Criteria, offset, limit
 
 	var buffer map[string]core.JSONBytes
 	if err := json.Unmarshal([]byte(strResp), &buffer); err != nil {
-		return nil, err
+		return nil, core.NewDecodeError(err)
 	}
 
 	var dataBuffer Detail
@@ -366,7 +354,7 @@ func (c *customer) Search(ctx context.Context, criteria Criteria, offset, limit
 	for key, dataBytes := range buffer {
 		switch {
 		case core.RgxNumber.MatchString(key):
-			if err := json.Unmarshal(dataBytes, &dataBuffer); err != nil {
+			if err := c.core.Unmarshal(dataBytes, &dataBuffer); err != nil {
 				return nil, err
 			}
 			dataBuffers = append(dataBuffers, dataBuffer)
@@ -412,15 +400,44 @@ func (c *customer) Suspension(ctx context.Context, toggle bool, customerID, reas
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	boolResult, err := core.ParseBool(bytesResp)
+	if err != nil {
+		return err
+	}
+	if !boolResult {
+		return core.ErrRcOperationFailed
+	}
+
+	return nil
+}
+
+func (c *customer) ResendVerificationEmail(ctx context.Context, customerID string) error {
+	if !core.RgxNumber.MatchString(customerID) {
+		return core.ErrRcInvalidCredential
+	}
+
+	data := url.Values{}
+	data.Add("customer-id", customerID)
+
+	resp, err := c.core.CallAPI(ctx, http.MethodPost, "customers", "resend-verification-email", data)
+	if err != nil {
+		return alreadyVerifiedError(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return alreadyVerifiedError(core.NewAPIError(resp.StatusCode, bytesResp))
 	}
 
-	boolResult, err := strconv.ParseBool(string(bytesResp))
+	boolResult, err := core.ParseBool(bytesResp)
 	if err != nil {
 		return err
 	}
@@ -431,6 +448,16 @@ func (c *customer) Suspension(ctx context.Context, toggle bool, customerID, reas
 	return nil
 }
 
+// alreadyVerifiedError wraps the API error with ErrAlreadyVerified when it reports a verified customer.
+func alreadyVerifiedError(err error) error {
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) && strings.Contains(strings.ToLower(apiErr.Message), "already verified") {
+		return fmt.Errorf("%w: %w", ErrAlreadyVerified, err)
+	}
+
+	return err
+}
+
 func (c *customer) ForgotPassword(ctx context.Context, username string) error {
 	if !core.RgxEmail.MatchString(username) {
 		return core.ErrRcInvalidCredential
@@ -448,15 +475,10 @@ func (c *customer) ForgotPassword(ctx context.Context, username string) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
-	boolResult, err := strconv.ParseBool(string(bytesResp))
+	boolResult, err := core.ParseBool(bytesResp)
 	if err != nil {
 		return err
 	}
@@ -484,15 +506,10 @@ func (c *customer) Delete(ctx context.Context, customerID string) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
-	boolResult, err := strconv.ParseBool(string(bytesResp))
+	boolResult, err := core.ParseBool(bytesResp)
 	if err != nil {
 		return err
 	}
@@ -531,25 +548,52 @@ func (c *customer) Details(ctx context.Context, customerIDOrEmail string) (*Deta
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	ret := new(Detail)
-	if err := json.Unmarshal(bytesResp, ret); err != nil {
+	if err := c.core.Unmarshal(bytesResp, ret); err != nil {
 		return nil, err
 	}
 
 	return ret, nil
 }
 
+// TaxIDs returns the tax identifiers of the customer, which are not decoded into Detail.
+func (c *customer) TaxIDs(ctx context.Context, customerID string) (TaxInfo, error) {
+	if !core.RgxNumber.MatchString(customerID) {
+		return nil, core.ErrRcInvalidCredential
+	}
+
+	data := url.Values{}
+	data.Add("customer-id", customerID)
+
+	resp, err := c.core.CallAPI(ctx, http.MethodGet, "customers", "details-by-id", data)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := c.core.Unmarshal(bytesResp, &raw); err != nil {
+		return nil, err
+	}
+
+	return parseTaxInfo(raw), nil
+}
+
 func (c *customer) ChangePassword(ctx context.Context, customerID, newPassword string) error {
 	if !matchPasswordWithPattern(newPassword, true) {
-		return errors.New("invalid password format")
+		return core.NewValidationError("invalid password format")
 	}
 
 	data := url.Values{}
@@ -568,15 +612,10 @@ func (c *customer) ChangePassword(ctx context.Context, customerID, newPassword s
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
-	boolResult, err := strconv.ParseBool(string(bytesResp))
+	boolResult, err := core.ParseBool(bytesResp)
 	if err != nil {
 		return err
 	}
@@ -604,12 +643,7 @@ func (c *customer) SignUp(ctx context.Context, regForm *SignUpForm) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	regForm.CustomerID = string(bytesResp)
@@ -0,0 +1,78 @@
+package customer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/internal/coretest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateLoginTokenWithOptions(t *testing.T) {
+	c, _ := coretest.New(core.Config{IsProduction: true}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `abc123`
+	})
+	cust := New(c)
+
+	token, err := cust.GenerateLoginTokenWithOptions(context.Background(), "12345", "192.0.2.1", LoginTokenOptions{
+		DashboardBaseURL: "https://panel.example.com/",
+		Role:             LoginRoleReseller,
+		Params:           url.Values{"lang": {"fr"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "abc123", token.String())
+	require.Equal(t, "https://panel.example.com/servlet/AutoLoginServlet?lang=fr&role=reseller&userLoginId=abc123", token.LoginURL())
+
+	token, err = cust.GenerateLoginToken(context.Background(), "12345", "192.0.2.1", "https://panel.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "https://panel.example.com/servlet/AutoLoginServlet?role=customer&userLoginId=abc123", token.LoginURL())
+
+	_, err = cust.GenerateLoginTokenWithOptions(context.Background(), "12345", "192.0.2.1", LoginTokenOptions{})
+	require.ErrorAs(t, err, new(*core.ValidationError))
+}
+
+func TestForgotPasswordMalformedResponse(t *testing.T) {
+	c, _ := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `<html>maintenance</html>`
+	})
+
+	err := New(c).ForgotPassword(context.Background(), "jane@example.com")
+	require.ErrorAs(t, err, new(*core.APIError))
+}
+
+func TestResendVerificationEmail(t *testing.T) {
+	cases := map[string]struct {
+		statusCode int
+		body       string
+		verified   bool
+		failed     bool
+	}{
+		"sent":                {http.StatusOK, `true`, false, false},
+		"not sent":            {http.StatusOK, `false`, false, true},
+		"failed status":       {http.StatusOK, `{"status":"ERROR","message":"Customer is already verified"}`, true, true},
+		"non-200 response":    {http.StatusInternalServerError, `{"status":"ERROR","message":"Email already verified."}`, true, true},
+		"other error message": {http.StatusInternalServerError, `{"status":"ERROR","message":"Invalid customer-id"}`, false, true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, transport := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+				return tc.statusCode, tc.body
+			})
+
+			err := New(c).ResendVerificationEmail(context.Background(), "12345")
+			require.Equal(t, tc.failed, err != nil, err)
+			require.Equal(t, tc.verified, errors.Is(err, ErrAlreadyVerified))
+			require.Equal(t, "12345", transport.Calls("customers/resend-verification-email")[0].Get("customer-id"))
+		})
+	}
+
+	c, transport := coretest.New(core.Config{}, nil)
+	for _, customerID := range []string{"", "abc", "12 345"} {
+		require.ErrorIs(t, New(c).ResendVerificationEmail(context.Background(), customerID), core.ErrRcInvalidCredential)
+	}
+	require.Empty(t, transport.Calls("customers/resend-verification-email"))
+}
@@ -1,6 +1,7 @@
 package customer
 
 import (
+	"encoding/json"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -12,9 +13,76 @@ import (
 	"github.com/mrehanabbasi/go-logicboxes/core"
 )
 
+type (
+	LoginRole string
+	TaxType   string
+	// TaxInfo holds the configured tax identifiers of a customer.
+	TaxInfo map[TaxType]string
+)
+
 type loginToken struct {
 	token   string
 	baseURL string
+	role    LoginRole
+	params  url.Values
+}
+
+// LoginTokenOptions customizes the auto-login URL built from a login token.
+type LoginTokenOptions struct {
+	// DashboardBaseURL is the (branded) control panel URL, required in production mode.
+	DashboardBaseURL string
+	// Role defaults to LoginRoleCustomer.
+	Role LoginRole
+	// Params are extra query parameters passed to the auto-login servlet.
+	Params url.Values
+}
+
+// Const for login roles.
+const (
+	LoginRoleCustomer LoginRole = "customer"
+	LoginRoleReseller LoginRole = "reseller"
+)
+
+// Const for tax identifier types, named after their query parameters.
+const (
+	TaxVATEurope     TaxType = "vat-id"
+	TaxVATRussia     TaxType = "russia-vat-id"
+	TaxGSTIndia      TaxType = "indian-gst-id"
+	TaxGSTAustralia  TaxType = "australia-gst-id"
+	TaxGSTNewZealand TaxType = "newzealand-gst-id"
+	TaxGSTSingapore  TaxType = "singapore-gst-id"
+)
+
+var taxTypes = []TaxType{
+	TaxVATEurope,
+	TaxVATRussia,
+	TaxGSTIndia,
+	TaxGSTAustralia,
+	TaxGSTNewZealand,
+	TaxGSTSingapore,
+}
+
+// parseTaxInfo reads the tax identifiers from a details response, matching the keys
+// regardless of their separators, as they are returned as either "vat-id" or "vatid".
+func parseTaxInfo(raw map[string]json.RawMessage) TaxInfo {
+	normalize := strings.NewReplacer("-", "", "_", "")
+	values := make(map[string]string, len(raw))
+	for key, val := range raw {
+		var str string
+		if err := json.Unmarshal(val, &str); err != nil || strings.TrimSpace(str) == "" {
+			continue
+		}
+		values[normalize.Replace(strings.ToLower(key))] = strings.TrimSpace(str)
+	}
+
+	taxInfo := TaxInfo{}
+	for _, taxType := range taxTypes {
+		if val, ok := values[normalize.Replace(string(taxType))]; ok {
+			taxInfo[taxType] = val
+		}
+	}
+
+	return taxInfo
 }
 
 type LoginToken interface {
@@ -127,18 +195,26 @@ func (t loginToken) String() string {
 
 func (t loginToken) URLFullPath() string {
 	data := url.Values{}
-	data.Add("role", "customer")
-	data.Add("userLoginId", t.String())
+	for key, values := range t.params {
+		data[key] = append(data[key], values...)
+	}
+
+	role := t.role
+	if role == "" {
+		role = LoginRoleCustomer
+	}
+	data.Set("role", string(role))
+	data.Set("userLoginId", t.String())
 	return "servlet/AutoLoginServlet?" + data.Encode()
 }
 
 func (t loginToken) LoginURL() string {
-	return strings.TrimRight(t.baseURL, "/") + t.URLFullPath()
+	return strings.TrimRight(t.baseURL, "/") + "/" + t.URLFullPath()
 }
 
 func (c *Detail) mergePrevious(prev *Detail) error {
 	if err := validator.New().Struct(c); err != nil {
-		return err
+		return &core.ValidationError{Err: err}
 	}
 
 	valueCurrent := reflect.ValueOf(c)
@@ -180,7 +256,7 @@ func (c *Detail) mergePrevious(prev *Detail) error {
 
 func (c Detail) URLValues() (url.Values, error) {
 	if err := validator.New().Struct(c); err != nil {
-		return url.Values{}, err
+		return url.Values{}, &core.ValidationError{Err: err}
 	}
 
 	wg := sync.WaitGroup{}
@@ -218,7 +294,7 @@ func (c Detail) URLValues() (url.Values, error) {
 //nolint:gocognit
 func (c Criteria) URLValues() (url.Values, error) {
 	if err := validator.New().Struct(c); err != nil {
-		return url.Values{}, err
+		return url.Values{}, &core.ValidationError{Err: err}
 	}
 
 	wg := sync.WaitGroup{}
@@ -293,7 +369,7 @@ func (r SignUpForm) URLValues() (url.Values, error) {
 		return url.Values{}, err
 	}
 	if err := valider.Struct(r); err != nil {
-		return url.Values{}, err
+		return url.Values{}, &core.ValidationError{Err: err}
 	}
 
 	wg := sync.WaitGroup{}
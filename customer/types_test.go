@@ -0,0 +1,39 @@
+package customer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaxInfo(t *testing.T) {
+	cases := map[string]struct {
+		raw  string
+		want TaxInfo
+	}{
+		"hyphenated keys": {
+			raw:  `{"vat-id":"DE123456789","indian-gst-id":"22AAAAA0000A1Z5"}`,
+			want: TaxInfo{TaxVATEurope: "DE123456789", TaxGSTIndia: "22AAAAA0000A1Z5"},
+		},
+		"joined keys": {
+			raw:  `{"vatid":"DE123456789","australiagstid":"51824753556","Singapore_GST_ID":"M90312345A"}`,
+			want: TaxInfo{TaxVATEurope: "DE123456789", TaxGSTAustralia: "51824753556", TaxGSTSingapore: "M90312345A"},
+		},
+		"empty values": {
+			raw:  `{"vatid":"","russia-vat-id":"  ","newzealand-gst-id":" 123-456-789 "}`,
+			want: TaxInfo{TaxGSTNewZealand: "123-456-789"},
+		},
+		"non-string values": {
+			raw:  `{"vatid":123456789,"russia-vat-id":null,"indian-gst-id":["22AAAAA0000A1Z5"],"name":"Jane"}`,
+			want: TaxInfo{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var raw map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal([]byte(tc.raw), &raw))
+			require.Equal(t, tc.want, parseTaxInfo(raw))
+		})
+	}
+}
@@ -13,10 +13,13 @@ import (
 	"strings"
 
 	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/domain"
 )
 
 type DNS interface {
-	ActivatingDNSService(ctx context.Context, orderID string) (*ActivatingDNSServiceResponse, error)
+	ResolveOrderID(ctx context.Context, domainName string) (string, error)
+	ActivatingDNSService(ctx context.Context, orderIDOrDomain string) (*ActivatingDNSServiceResponse, error)
+	ActivateDNSBatch(ctx context.Context, orderIDs []string) (map[string]*ActivatingDNSServiceResponse, error)
 	AddingIPv4AddressRecord(ctx context.Context, domainName, value, host string, ttl int) (*StdResponse, error)
 	AddingIPv6AddressRecord(ctx context.Context, domainName, value, host string, ttl int) (*StdResponse, error)
 	AddingCNAMERecord(ctx context.Context, domainName, value, host string, ttl int) (*StdResponse, error)
@@ -43,6 +46,7 @@ type DNS interface {
 		noOfRecords, pageNo int,
 		host, value string,
 	) (*SearchingDNSRecords, error)
+	VerifyZone(ctx context.Context, domainName string, intended []Record) (ZoneDiff, error)
 	DeletingDNSRecord(ctx context.Context, host, value string) (*StdResponse, error)
 	DeletingIPv4AddressRecord(ctx context.Context, domainName, host, value string) (*StdResponse, error)
 	DeletingIPv6AddressRecord(ctx context.Context, domainName, host, value string) (*StdResponse, error)
@@ -61,12 +65,30 @@ type dns struct {
 	core core.Core
 }
 
-func (d *dns) ActivatingDNSService(ctx context.Context, orderID string) (*ActivatingDNSServiceResponse, error) {
+func (d *dns) ResolveOrderID(ctx context.Context, domainName string) (string, error) {
+	return domain.New(d.core).GetOrderID(ctx, domainName)
+}
+
+// ActivatingDNSService accepts either the order ID or the domain name of the order.
+func (d *dns) ActivatingDNSService(ctx context.Context, orderIDOrDomain string) (*ActivatingDNSServiceResponse, error) {
+	orderID := orderIDOrDomain
+	if !core.RgxNumber.MatchString(orderID) {
+		var err error
+		orderID, err = d.ResolveOrderID(ctx, orderIDOrDomain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	data := make(url.Values)
 	data.Add("order-id", orderID)
 
 	resp, err := d.core.CallAPI(ctx, http.MethodPost, "dns", "activate", data)
 	if err != nil {
+		var apiErr *core.APIError
+		if errors.As(err, &apiErr) && isAlreadyActivated(apiErr.Message) {
+			return &ActivatingDNSServiceResponse{OrderID: orderID, AlreadyActive: true}, nil
+		}
 		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
@@ -77,21 +99,37 @@ func (d *dns) ActivatingDNSService(ctx context.Context, orderID string) (*Activa
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
+		apiErr := core.NewAPIError(resp.StatusCode, bytesResp)
+		if isAlreadyActivated(apiErr.Message) {
+			return &ActivatingDNSServiceResponse{OrderID: orderID, AlreadyActive: true}, nil
 		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, apiErr
 	}
 
 	var result ActivatingDNSServiceResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
+	if isAlreadyActivated(result.Msg) {
+		result.OrderID = orderID
+		result.AlreadyActive = true
+	}
+
 	return &result, nil
 }
 
+// isAlreadyActivated reports whether the message returned by the activate call
+// means the DNS service had been activated before.
+func isAlreadyActivated(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "already") && strings.Contains(msg, "activ")
+}
+
+func (d *dns) ActivateDNSBatch(ctx context.Context, orderIDs []string) (map[string]*ActivatingDNSServiceResponse, error) {
+	return core.RunBatch(ctx, orderIDs, d.ActivatingDNSService)
+}
+
 func (d *dns) AddingIPv4AddressRecord(ctx context.Context, domainName, value, host string, ttl int) (*StdResponse, error) {
 	data := make(url.Values)
 	data.Add("domain-name", domainName)
@@ -111,15 +149,11 @@ func (d *dns) AddingIPv4AddressRecord(ctx context.Context, domainName, value, ho
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -145,15 +179,11 @@ func (d *dns) AddingIPv6AddressRecord(ctx context.Context, domainName, value, ho
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -179,15 +209,11 @@ func (d *dns) AddingCNAMERecord(ctx context.Context, domainName, value, host str
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -214,15 +240,11 @@ func (d *dns) AddingMXRecord(ctx context.Context, domainName, value, host string
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -248,15 +270,11 @@ func (d *dns) AddingNSRecord(ctx context.Context, domainName, value, host string
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	err = json.Unmarshal(bytesResp, &result)
+	err = d.core.Unmarshal(bytesResp, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -283,15 +301,11 @@ func (d *dns) AddingTXTRecord(ctx context.Context, domainName, value, host strin
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -320,15 +334,11 @@ func (d *dns) AddingSRVRecord(ctx context.Context, domainName, value, host strin
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -359,15 +369,11 @@ func (d *dns) ModifyingIPv4AddressRecord(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -398,15 +404,11 @@ func (d *dns) ModifyingIPv6AddressRecord(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -433,15 +435,11 @@ func (d *dns) ModifyingCNAMERecord(ctx context.Context, domainName, host, curren
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -473,15 +471,11 @@ func (d *dns) ModifyingMXRecord(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -508,15 +502,11 @@ func (d *dns) ModifyingNSRecord(ctx context.Context, domainName, host, currentVa
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -543,15 +533,11 @@ func (d *dns) ModifyingTXTRecord(ctx context.Context, domainName, host, currentV
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -585,15 +571,11 @@ func (d *dns) ModifyingSRVRecord(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -625,15 +607,11 @@ func (d *dns) ModifyingSOARecord(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -667,16 +645,12 @@ func (d *dns) SearchingDNSRecords(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var records SearchingDNSRecords
 	var result map[string]interface{}
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -701,7 +675,7 @@ func (d *dns) SearchingDNSRecords(
 		}
 
 		var record Record
-		if err := json.Unmarshal(b, &record); err != nil {
+		if err := d.core.Unmarshal(b, &record); err != nil {
 			return nil, err
 		}
 
@@ -728,15 +702,11 @@ func (d *dns) DeletingDNSRecord(ctx context.Context, host, value string) (*StdRe
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -761,15 +731,11 @@ func (d *dns) DeletingIPv4AddressRecord(ctx context.Context, domainName, host, v
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -794,15 +760,11 @@ func (d *dns) DeletingIPv6AddressRecord(ctx context.Context, domainName, host, v
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -827,15 +789,11 @@ func (d *dns) DeletingCNAMERecord(ctx context.Context, domainName, host, value s
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -860,15 +818,11 @@ func (d *dns) DeletingMXRecord(ctx context.Context, domainName, host, value stri
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -893,15 +847,11 @@ func (d *dns) DeletingNSRecord(ctx context.Context, domainName, host, value stri
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -926,15 +876,11 @@ func (d *dns) DeletingTXTRecord(ctx context.Context, domainName, host, value str
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -961,15 +907,11 @@ func (d *dns) DeletingSRVRecord(ctx context.Context, domainName, host, value str
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,79 @@
+package dns
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/internal/coretest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivatingDNSServiceAlreadyActive(t *testing.T) {
+	cases := map[string]struct {
+		statusCode int
+		body       string
+	}{
+		"failed status":    {http.StatusOK, `{"status":"ERROR","message":"DNS service is already activated for this order"}`},
+		"non-200 response": {http.StatusInternalServerError, `{"status":"ERROR","message":"DNS already Active"}`},
+		"success message":  {http.StatusOK, `{"status":"Success","msg":"Dns service already active"}`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, _ := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+				return tc.statusCode, tc.body
+			})
+
+			res, err := New(c).ActivatingDNSService(context.Background(), "12345")
+			require.NoError(t, err)
+			require.True(t, res.AlreadyActive)
+			require.Equal(t, "12345", res.OrderID)
+		})
+	}
+}
+
+func TestActivatingDNSServiceFailed(t *testing.T) {
+	for _, msg := range []string{"Activation failed as the order is inactive", "Order already deleted"} {
+		c, _ := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+			return http.StatusOK, `{"status":"ERROR","message":"` + msg + `"}`
+		})
+
+		_, err := New(c).ActivatingDNSService(context.Background(), "12345")
+		require.ErrorAs(t, err, new(*core.APIError), msg)
+	}
+
+	c, _ := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"status":"Success","msg":"DNS activated","zoneid":"1"}`
+	})
+	res, err := New(c).ActivatingDNSService(context.Background(), "12345")
+	require.NoError(t, err)
+	require.False(t, res.AlreadyActive)
+}
+
+func TestActivatingDNSServiceByDomainName(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(api string, data url.Values) (int, string) {
+		switch api {
+		case "domains/orderid":
+			if data.Get("domain-name") == "example.com" {
+				return http.StatusOK, `12345`
+			}
+			return http.StatusInternalServerError, `{"status":"ERROR","message":"Website doesn't exist for missing.com"}`
+		case "dns/activate":
+			return http.StatusOK, `{"status":"Success","msg":"DNS activated","zoneid":"1"}`
+		}
+		return http.StatusNotFound, `{"status":"ERROR","message":"unexpected call"}`
+	})
+
+	res, err := New(c).ActivatingDNSService(context.Background(), "example.com")
+	require.NoError(t, err)
+	require.False(t, res.AlreadyActive)
+	require.Len(t, transport.Calls("dns/activate"), 1)
+	require.Equal(t, "12345", transport.Calls("dns/activate")[0].Get("order-id"))
+
+	_, err = New(c).ActivatingDNSService(context.Background(), "missing.com")
+	require.ErrorAs(t, err, new(*core.APIError))
+	require.Len(t, transport.Calls("domains/orderid"), 2)
+	require.Len(t, transport.Calls("dns/activate"), 1)
+}
@@ -7,8 +7,9 @@ type StdResponse struct {
 
 type ActivatingDNSServiceResponse struct {
 	StdResponse
-	ZoneID  string `json:"zoneid"`
-	OrderID string `json:"orderid"`
+	ZoneID        string `json:"zoneid"`
+	OrderID       string `json:"orderid"`
+	AlreadyActive bool   `json:"-"`
 }
 
 type SearchingDNSRecords struct {
@@ -23,6 +24,7 @@ type Record struct {
 	Type       string `json:"type,omitempty"`
 	Host       string `json:"host,omitempty"`
 	Value      string `json:"value,omitempty"`
+	Priority   string `json:"priority,omitempty"`
 }
 
 type RecordType string
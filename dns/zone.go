@@ -0,0 +1,185 @@
+package dns
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// zonePageSize is the number of records fetched per page when reading a whole zone.
+const zonePageSize = 50
+
+// ZoneDiff holds the changes needed to bring a zone to the intended state.
+type ZoneDiff struct {
+	Add    []Record
+	Remove []Record
+	Modify []RecordChange
+}
+
+// RecordChange is a record whose TTL or priority differs from the intended one.
+type RecordChange struct {
+	Current  Record
+	Intended Record
+}
+
+// IsInSync reports whether the zone has no drift from the intended state.
+func (z ZoneDiff) IsInSync() bool {
+	return len(z.Add) == 0 && len(z.Remove) == 0 && len(z.Modify) == 0
+}
+
+// VerifyZone compares all the records of the zone with the intended records, which must describe
+// the whole zone. Records are matched by type, host and value, so multi-value records are compared
+// one value at a time; matched records differing in TTL or priority are reported as modified.
+// An intended record with an empty TTL or priority matches any current one.
+func (d *dns) VerifyZone(ctx context.Context, domainName string, intended []Record) (ZoneDiff, error) {
+	current, err := d.zoneRecords(ctx, domainName)
+	if err != nil {
+		return ZoneDiff{}, err
+	}
+
+	return diffZone(domainName, current, intended), nil
+}
+
+func (d *dns) zoneRecords(ctx context.Context, domainName string) ([]Record, error) {
+	types := []RecordType{RecordA, RecordAAAA, RecordCNAME, RecordMX, RecordNS, RecordTXT, RecordSRV}
+
+	wg := sync.WaitGroup{}
+	rwMutex := sync.RWMutex{}
+	records := make([]Record, 0)
+	var errs []error
+
+	for _, recordType := range types {
+		wg.Add(1)
+		go func(t RecordType) {
+			defer wg.Done()
+			res, err := d.recordsOfType(ctx, domainName, t)
+			rwMutex.Lock()
+			defer rwMutex.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			records = append(records, res...)
+		}(recordType)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return records, nil
+}
+
+func (d *dns) recordsOfType(ctx context.Context, domainName string, recordType RecordType) ([]Record, error) {
+	records := make([]Record, 0)
+	for pageNo := 1; ; pageNo++ {
+		res, err := d.SearchingDNSRecords(ctx, domainName, recordType, zonePageSize, pageNo, "", "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range res.Records {
+			if record.Type == "" {
+				record.Type = string(recordType)
+			}
+			records = append(records, *record)
+		}
+
+		if len(res.Records) == 0 {
+			return records, nil
+		}
+
+		// Without a usable total, the zone ends at the first page which is not full.
+		total, err := strconv.Atoi(res.Recsindb)
+		if err != nil && len(res.Records) < zonePageSize || err == nil && len(records) >= total {
+			return records, nil
+		}
+	}
+}
+
+func diffZone(domainName string, current, intended []Record) ZoneDiff {
+	currentByKey := make(map[string]Record, len(current))
+	for _, record := range current {
+		currentByKey[recordKey(domainName, record)] = record
+	}
+
+	diff := ZoneDiff{
+		Add:    make([]Record, 0),
+		Remove: make([]Record, 0),
+		Modify: make([]RecordChange, 0),
+	}
+
+	seen := make(map[string]bool, len(intended))
+	for _, record := range intended {
+		key := recordKey(domainName, record)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		existing, ok := currentByKey[key]
+		switch {
+		case !ok:
+			diff.Add = append(diff.Add, record)
+		case !sameSetting(existing.TimeToLive, record.TimeToLive) || !sameSetting(existing.Priority, record.Priority):
+			diff.Modify = append(diff.Modify, RecordChange{Current: existing, Intended: record})
+		}
+	}
+
+	for key, record := range currentByKey {
+		if !seen[key] {
+			diff.Remove = append(diff.Remove, record)
+		}
+	}
+
+	sortRecords(domainName, diff.Add)
+	sortRecords(domainName, diff.Remove)
+	sort.Slice(diff.Modify, func(i, j int) bool {
+		return recordKey(domainName, diff.Modify[i].Intended) < recordKey(domainName, diff.Modify[j].Intended)
+	})
+
+	return diff
+}
+
+func sortRecords(domainName string, records []Record) {
+	sort.Slice(records, func(i, j int) bool {
+		return recordKey(domainName, records[i]) < recordKey(domainName, records[j])
+	})
+}
+
+func sameSetting(current, intended string) bool {
+	return intended == "" || strings.TrimSpace(current) == strings.TrimSpace(intended)
+}
+
+// recordKey identifies a record by its type, host relative to the zone and value.
+func recordKey(domainName string, record Record) string {
+	recordType := strings.ToUpper(record.Type)
+	return recordType + " " + normalizeHost(domainName, record.Host) + " " + normalizeValue(RecordType(recordType), record.Value)
+}
+
+func normalizeHost(domainName, host string) string {
+	domainName = strings.ToLower(strings.TrimSuffix(domainName, "."))
+	host = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(host), "."))
+	if host == "" || host == "@" || host == domainName {
+		return "@"
+	}
+
+	return strings.TrimSuffix(host, "."+domainName)
+}
+
+func normalizeValue(recordType RecordType, value string) string {
+	value = strings.TrimSpace(value)
+	switch recordType {
+	case RecordTXT:
+		return strings.Trim(value, `"`)
+	case RecordCNAME, RecordMX, RecordNS, RecordSRV:
+		return strings.ToLower(strings.TrimSuffix(value, "."))
+	case RecordAAAA:
+		return strings.ToLower(value)
+	default:
+		return value
+	}
+}
@@ -0,0 +1,78 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/internal/coretest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffZone(t *testing.T) {
+	current := []Record{
+		{Type: "A", Host: "www", Value: "192.0.2.1", TimeToLive: "3600"},
+		{Type: "A", Host: "www", Value: "192.0.2.2", TimeToLive: "3600"},
+		{Type: "MX", Host: "example.com", Value: "mx1.example.com.", TimeToLive: "3600", Priority: "10"},
+		{Type: "TXT", Host: "@", Value: `"v=spf1 -all"`, TimeToLive: "3600"},
+	}
+	intended := []Record{
+		{Type: "A", Host: "www.example.com", Value: "192.0.2.1", TimeToLive: "3600"},
+		{Type: "A", Host: "www", Value: "192.0.2.3"},
+		{Type: "MX", Host: "@", Value: "MX1.example.com", Priority: "20"},
+		{Type: "TXT", Value: "v=spf1 -all"},
+	}
+
+	diff := diffZone("example.com", current, intended)
+	require.False(t, diff.IsInSync())
+	require.Equal(t, []Record{{Type: "A", Host: "www", Value: "192.0.2.3"}}, diff.Add)
+	require.Equal(t, []Record{current[1]}, diff.Remove)
+	require.Len(t, diff.Modify, 1)
+	require.Equal(t, current[2], diff.Modify[0].Current)
+
+	require.True(t, diffZone("example.com", current, current).IsInSync())
+}
+
+// zonePages answers record searches with the given pages, leaving out the total of the zone.
+func zonePages(pages ...[]Record) coretest.Handler {
+	return func(_ string, data url.Values) (int, string) {
+		pageNo, _ := strconv.Atoi(data.Get("page-no"))
+
+		rows := map[string]Record{}
+		if pageNo <= len(pages) {
+			for i, record := range pages[pageNo-1] {
+				rows[strconv.Itoa(i+1)] = record
+			}
+		}
+		body, _ := json.Marshal(rows)
+
+		return http.StatusOK, string(body)
+	}
+}
+
+func TestRecordsOfTypeWithoutTotal(t *testing.T) {
+	page := func(n int) []Record {
+		records := make([]Record, n)
+		for i := range records {
+			records[i] = Record{Host: "www", Value: "192.0.2." + strconv.Itoa(i)}
+		}
+		return records
+	}
+	c, transport := coretest.New(core.Config{}, zonePages(page(zonePageSize), page(zonePageSize), page(3)))
+
+	records, err := (&dns{core: c}).recordsOfType(context.Background(), "example.com", RecordA)
+	require.NoError(t, err)
+	require.Len(t, records, 2*zonePageSize+3)
+	require.Equal(t, "A", records[0].Type)
+	require.Len(t, transport.Calls("dns/manage/search-records"), 3)
+
+	c, transport = coretest.New(core.Config{}, zonePages(page(zonePageSize)))
+	records, err = (&dns{core: c}).recordsOfType(context.Background(), "example.com", RecordA)
+	require.NoError(t, err)
+	require.Len(t, records, zonePageSize)
+	require.Len(t, transport.Calls("dns/manage/search-records"), 2)
+}
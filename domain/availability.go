@@ -1,6 +1,11 @@
 package domain
 
-import "github.com/mrehanabbasi/go-logicboxes/core"
+import (
+	"sort"
+	"strings"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+)
 
 type Registration struct {
 	Key    core.DomainKey     `json:"classkey"`
@@ -8,3 +13,31 @@ type Registration struct {
 }
 
 type Availabilities map[string]Registration
+
+// AvailabilityChunkSize is the maximum number of domain names sent in a single availability check of a bulk check.
+const AvailabilityChunkSize = 20
+
+// PartialError is returned by a bulk availability check when some of the chunks failed.
+// Failed holds the domain names, with their TLD, which could not be checked.
+type PartialError struct {
+	Failed []string
+	Errs   core.BatchError
+}
+
+func (e *PartialError) Error() string {
+	return "availability check failed for " + strings.Join(e.Failed, ", ") + ": " + e.Errs.Error()
+}
+
+func (e *PartialError) Unwrap() []error {
+	return e.Errs.Unwrap()
+}
+
+func newPartialError(errs core.BatchError, chunks map[string][]string) *PartialError {
+	failed := make([]string, 0)
+	for key := range errs {
+		failed = append(failed, chunks[key]...)
+	}
+	sort.Strings(failed)
+
+	return &PartialError{Failed: failed, Errs: errs}
+}
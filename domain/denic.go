@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// DENICCheckResult is the outcome of the DENIC predelegation check of the name servers of a .de domain.
+type DENICCheckResult struct {
+	IsPassed    bool
+	Message     string
+	NameServers []DENICNameServerCheck
+}
+
+type DENICNameServerCheck struct {
+	NameServer string
+	IsPassed   bool
+	Messages   []string
+}
+
+// UnmarshalJSON decodes the recheck response. Besides the overall status and message,
+// every object keyed by a name server holds the result of the checks run against it.
+// Keys are name servers, so StrictDecoding does not apply to DENICCheckResult.
+func (r *DENICCheckResult) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	var status string
+	hasStatus := false
+	nameServers := make([]DENICNameServerCheck, 0)
+	for key, val := range raw {
+		switch strings.ToLower(key) {
+		case "status":
+			hasStatus = json.Unmarshal(val, &status) == nil
+		case "message", "msg":
+			r.Message = strings.Join(denicMessages(val), "; ")
+		default:
+			var check struct {
+				Status   string          `json:"status"`
+				Message  json.RawMessage `json:"message"`
+				Messages json.RawMessage `json:"messages"`
+				Errors   json.RawMessage `json:"errors"`
+			}
+			if err := json.Unmarshal(val, &check); err != nil {
+				continue
+			}
+
+			messages := denicMessages(check.Errors)
+			messages = append(messages, denicMessages(check.Messages)...)
+			messages = append(messages, denicMessages(check.Message)...)
+			isPassed := isDENICPassed(check.Status)
+			if check.Status == "" {
+				isPassed = len(denicMessages(check.Errors)) == 0
+			}
+			nameServers = append(nameServers, DENICNameServerCheck{
+				NameServer: key,
+				IsPassed:   isPassed,
+				Messages:   messages,
+			})
+		}
+	}
+
+	sort.Slice(nameServers, func(i, j int) bool {
+		return nameServers[i].NameServer < nameServers[j].NameServer
+	})
+	r.NameServers = nameServers
+
+	if hasStatus {
+		r.IsPassed = isDENICPassed(status)
+		return nil
+	}
+
+	r.IsPassed = true
+	for i := range nameServers {
+		if !nameServers[i].IsPassed {
+			r.IsPassed = false
+			break
+		}
+	}
+
+	return nil
+}
+
+// Failed returns the checks of the name servers which failed predelegation.
+func (r *DENICCheckResult) Failed() []DENICNameServerCheck {
+	failed := make([]DENICNameServerCheck, 0)
+	for i := range r.NameServers {
+		if !r.NameServers[i].IsPassed {
+			failed = append(failed, r.NameServers[i])
+		}
+	}
+
+	return failed
+}
+
+func isDENICPassed(status string) bool {
+	switch strings.ToLower(status) {
+	case "success", "ok", "passed", "true":
+		return true
+	default:
+		return false
+	}
+}
+
+// denicMessages decodes a message reported either as a string or a list of strings.
+func denicMessages(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var msg string
+	if err := json.Unmarshal(raw, &msg); err == nil {
+		if msg == "" {
+			return nil
+		}
+		return []string{msg}
+	}
+
+	var msgs []string
+	if err := json.Unmarshal(raw, &msgs); err == nil {
+		return msgs
+	}
+
+	return nil
+}
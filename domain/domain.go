@@ -5,22 +5,40 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 
+	"github.com/mrehanabbasi/go-logicboxes/contact"
 	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/pricing"
 )
 
 type domain struct {
-	core core.Core
+	core               core.Core
+	sixtyDayLockOptOut bool
+}
+
+// Option configures the client returned by New.
+type Option func(*domain)
+
+// WithForcedSixtyDayLockOptOut makes ModifyContacts opt out of the 60-day transfer lock on every call,
+// whatever its sixtyDayLockOptout argument. LogicBoxes has no reseller-wide setting for it, so the policy
+// is kept in the client configuration and can be read back with ForcesSixtyDayLockOptOut.
+func WithForcedSixtyDayLockOptOut() Option {
+	return func(d *domain) {
+		d.sixtyDayLockOptOut = true
+	}
 }
 
 type Domain interface {
+	ForcesSixtyDayLockOptOut() bool
 	CheckAvailability(ctx context.Context, domainsWithoutTLD, tlds []string) (Availabilities, error)
-	SuggestNames(ctx context.Context, keyword, tldOnly string, exactMatch, adult bool) (SuggestNames, error)
+	CheckAvailabilityBulk(ctx context.Context, domainsWithoutTLD, tlds []string) (Availabilities, error)
+	SuggestNames(ctx context.Context, keyword string, opts SuggestNamesOptions) (SuggestNames, error)
 	Register(
 		ctx context.Context,
 		domainName string,
@@ -32,6 +50,8 @@ type Domain interface {
 		discountAmount float64,
 		purchasePremiumDNS bool,
 	) (*RegisterResponse, error)
+	SetRenewalPreferences(ctx context.Context, orderID string, prefs RenewalPreferences) (*StdResponse, error)
+	QuickRegister(ctx context.Context, req QuickRegisterRequest) (*RegisterResponse, error)
 	Transfer(
 		ctx context.Context,
 		domainName, authCode, customerID, regContactID, adminContactID, techContactID, billingContactID, invoiceOption string,
@@ -41,9 +61,21 @@ type Domain interface {
 		purchasePremiumDNS bool,
 	) (*RegisterResponse, error)
 	ValidatingTransferRequest(ctx context.Context, domainName string) (bool, error)
+	SearchOrders(ctx context.Context, criteria OrderCriteria, offset, limit uint16) (*OrderSearchResult, error)
+	ListByCustomer(
+		ctx context.Context,
+		customerID string,
+		filter DomainListFilter,
+		pageNo, perPage uint16,
+	) (*OrderSearchResult, error)
 	GetCustomerDefaultNameServers(ctx context.Context, customerID string) ([]string, error)
+	PreviewRenewal(ctx context.Context, orderID string, years int) (*RenewalPreview, error)
 	GetOrderID(ctx context.Context, domainName string) (string, error)
+	GetOrderIDs(ctx context.Context, domainName string) ([]string, error)
 	GetRegistrationOrderDetails(ctx context.Context, orderID string, options []string) (*OrderDetail, error)
+	GetOrderContacts(ctx context.Context, orderID string) (*OrderContacts, error)
+	GetRegistrantDetails(ctx context.Context, orderID string) (*RegistrantSnapshot, error)
+	GetDetailsByDomains(ctx context.Context, domainNames []string, options []OrderDetailOption) (map[string]*OrderDetail, error)
 	ModifyNameServers(ctx context.Context, orderID string, ns []string) (*NameServersResponse, error)
 	AddChildNameServer(ctx context.Context, orderID, cns string, ips []string) (*NameServersResponse, error)
 	ModifyChildNameServerHostName(ctx context.Context, orderID, oldCNS, newCNS string) (*NameServersResponse, error)
@@ -65,19 +97,31 @@ type Domain interface {
 	ApplyTheftProtectionLock(ctx context.Context, orderID string) (*TheftProtectionLockResponse, error)
 	RemoveTheftProtectionLock(ctx context.Context, orderID string) (*TheftProtectionLockResponse, error)
 	GetTheListOfLocksAppliedOnDomainName(ctx context.Context, orderID string) (*GetTheListOfLocksAppliedOnDomainNameResponse, error)
+	ListLocks(ctx context.Context, orderID string) ([]Lock, error)
+	GetRegistrantLock(ctx context.Context, orderID string) (*RegistrantLock, error)
+	IsTheftProtectionLocked(ctx context.Context, orderID string) (bool, error)
+	ModifyTELWhoisPreference(ctx context.Context, orderID string, whoisType TELWhoisType, publish TELPublish) error
+	GetTELWhoisPreference(ctx context.Context, orderID string) (*TELWhoisPreference, error)
 	CancelTransfer(ctx context.Context, orderID string) (*CancelTransferResponse, error)
+	CancelAction(ctx context.Context, eaqID string) (*CancelResponse, error)
 	Suspend(ctx context.Context, orderID, reason string) (*TheftProtectionLockResponse, error)
 	Unsuspend(ctx context.Context, orderID string) (*TheftProtectionLockResponse, error)
 	Delete(ctx context.Context, orderID string) (*DeleteResponse, error)
+	RecheckingNSWithDERegistry(ctx context.Context, orderID string) (*DENICCheckResult, error)
 }
 
-func New(c core.Core) Domain {
-	return &domain{c}
+func New(c core.Core, opts ...Option) Domain {
+	d := &domain{core: c}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
 }
 
 func (d *domain) CheckAvailability(ctx context.Context, domainName, tlds []string) (Availabilities, error) {
 	if len(domainName) == 0 || len(tlds) == 0 {
-		return Availabilities{}, errors.New("domainnames and tlds must not empty")
+		return Availabilities{}, core.NewValidationError("domainnames and tlds must not empty")
 	}
 
 	data := url.Values{}
@@ -96,27 +140,73 @@ func (d *domain) CheckAvailability(ctx context.Context, domainName, tlds []strin
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	availabilities := Availabilities{}
-	if err := json.Unmarshal(bytesResp, &availabilities); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &availabilities); err != nil {
 		return nil, err
 	}
 
 	return availabilities, nil
 }
 
-func (d *domain) SuggestNames(ctx context.Context, keyword, tldOnly string, exactMatch, adult bool) (SuggestNames, error) {
+// CheckAvailabilityBulk checks the availability in chunks of one TLD and at most AvailabilityChunkSize names.
+// When some chunks fail, the availabilities of the other chunks are returned along with a *PartialError.
+func (d *domain) CheckAvailabilityBulk(ctx context.Context, domainsWithoutTLD, tlds []string) (Availabilities, error) {
+	if len(domainsWithoutTLD) == 0 || len(tlds) == 0 {
+		return Availabilities{}, core.NewValidationError("domainnames and tlds must not empty")
+	}
+
+	type chunk struct {
+		names []string
+		tld   string
+	}
+	chunks := make(map[string]chunk)
+	chunkDomains := make(map[string][]string)
+	for _, tld := range tlds {
+		for start := 0; start < len(domainsWithoutTLD); start += AvailabilityChunkSize {
+			end := min(start+AvailabilityChunkSize, len(domainsWithoutTLD))
+			names := domainsWithoutTLD[start:end]
+			key := tld + "[" + strconv.Itoa(start) + ":" + strconv.Itoa(end) + "]"
+			chunks[key] = chunk{names: names, tld: tld}
+			for _, name := range names {
+				chunkDomains[key] = append(chunkDomains[key], name+"."+tld)
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(chunks))
+	for key := range chunks {
+		keys = append(keys, key)
+	}
+
+	results, err := core.RunBatch(ctx, keys, func(ctx context.Context, key string) (Availabilities, error) {
+		return d.CheckAvailability(ctx, chunks[key].names, []string{chunks[key].tld})
+	})
+
+	availabilities := Availabilities{}
+	for _, res := range results {
+		for domainName, registration := range res {
+			availabilities[domainName] = registration
+		}
+	}
+
+	var errs core.BatchError
+	if errors.As(err, &errs) {
+		return availabilities, newPartialError(errs, chunkDomains)
+	}
+
+	return availabilities, err
+}
+
+func (d *domain) SuggestNames(ctx context.Context, keyword string, opts SuggestNamesOptions) (SuggestNames, error) {
 	data := make(url.Values)
 	data.Add("keyword", keyword)
-	data.Add("tld-only", tldOnly)
-	data.Add("exact-match", strconv.FormatBool(exactMatch))
-	data.Add("adult", strconv.FormatBool(adult))
+	data.Add("tld-only", opts.TLDOnly)
+	data.Add("exact-match", strconv.FormatBool(opts.ExactMatch))
+	data.Add("adult", strconv.FormatBool(opts.Adult))
+	data.Add("add-related", strconv.FormatBool(opts.AddRelated))
 
 	resp, err := d.core.CallAPI(ctx, http.MethodGet, "domains/v5", "suggest-names", data)
 	if err != nil {
@@ -130,16 +220,11 @@ func (d *domain) SuggestNames(ctx context.Context, keyword, tldOnly string, exac
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	suggestNames := SuggestNames{}
-	if err := json.Unmarshal(bytesResp, &suggestNames); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &suggestNames); err != nil {
 		return nil, err
 	}
 
@@ -187,21 +272,155 @@ func (d *domain) Register(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result RegisterResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SetRenewalPreferences sets the auto-renewal of the order without renewing it.
+// The renewal invoices are paid unless prefs.InvoiceOption is set.
+func (d *domain) SetRenewalPreferences(ctx context.Context, orderID string, prefs RenewalPreferences) (*StdResponse, error) {
+	if !core.RgxNumber.MatchString(orderID) {
+		return nil, core.ErrRcInvalidCredential
+	}
+	if prefs.Years == 0 {
+		prefs.Years = 1
+	}
+	if prefs.Years < 1 || prefs.Years > 10 {
+		return nil, core.NewValidationError("renewal years must be between 1 and 10")
+	}
+	if prefs.InvoiceOption == "" {
+		prefs.InvoiceOption = InvoicePayInvoice
+	}
+	switch prefs.InvoiceOption {
+	case InvoiceNoInvoice, InvoicePayInvoice, InvoiceKeepInvoice, InvoiceOnlyAdd:
+	default:
+		return nil, core.NewValidationError("invalid invoice option")
+	}
+
+	data := make(url.Values)
+	data.Add("order-id", orderID)
+	data.Add("auto-renew", strconv.FormatBool(prefs.AutoRenew))
+	data.Add("auto-renew-years", strconv.Itoa(prefs.Years))
+	data.Add("invoice-option", prefs.InvoiceOption)
+
+	resp, err := d.core.CallAPI(ctx, http.MethodPost, "domains", "modify-auto-renew", data)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	var result StdResponse
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
+// QuickRegister registers an available domain for a customer with a single contact used for all roles.
+// The contact is created from req.Contact when req.ContactID is empty, and the customer's default name
+// servers are used when req.NameServers is empty. The invoice is paid unless req.InvoiceOption is set.
+func (d *domain) QuickRegister(ctx context.Context, req QuickRegisterRequest) (*RegisterResponse, error) {
+	if !core.RgxNumber.MatchString(req.CustomerID) {
+		return nil, core.ErrRcInvalidCredential
+	}
+
+	// Availability results are keyed by the lowercased domain name.
+	req.DomainName = strings.ToLower(req.DomainName)
+	label, tld, ok := strings.Cut(req.DomainName, ".")
+	if !ok || label == "" || tld == "" {
+		return nil, core.NewValidationError("domain name must have a tld")
+	}
+
+	if req.Years <= 0 {
+		req.Years = 1
+	}
+	if req.InvoiceOption == "" {
+		req.InvoiceOption = InvoicePayInvoice
+	}
+
+	availabilities, err := d.CheckAvailability(ctx, []string{label}, []string{tld})
+	if err != nil {
+		return nil, fmt.Errorf("check availability: %w", err)
+	}
+	if availabilities[req.DomainName].Status != DomRegUnregistered {
+		return nil, core.NewValidationError(req.DomainName + " is not available")
+	}
+
+	contactID, err := d.ensureContact(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("ensure contact: %w", err)
+	}
+
+	ns := req.NameServers
+	if len(ns) == 0 {
+		ns, err = d.GetCustomerDefaultNameServers(ctx, req.CustomerID)
+		if err != nil {
+			return nil, fmt.Errorf("get default name servers: %w", err)
+		}
+	}
+
+	result, err := d.Register(
+		ctx,
+		req.DomainName,
+		req.Years,
+		ns,
+		req.CustomerID, contactID, contactID, contactID, contactID, req.InvoiceOption,
+		req.PurchasePrivacy, req.PurchasePrivacy, req.AutoRenew,
+		"", "",
+		0,
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("register: %w", err)
+	}
+
+	return result, nil
+}
+
+func (d *domain) ensureContact(ctx context.Context, req QuickRegisterRequest) (string, error) {
+	c := contact.New(d.core)
+	if req.ContactID != "" {
+		if _, err := c.Details(ctx, req.ContactID); err != nil {
+			return "", err
+		}
+		return req.ContactID, nil
+	}
+
+	if req.Contact == nil {
+		return "", core.NewValidationError("contact id or contact detail must not empty")
+	}
+
+	detail := *req.Contact
+	if detail.CustomerID == "" {
+		detail.CustomerID = req.CustomerID
+	}
+	if detail.Type == "" {
+		detail.Type = contact.TypeContact
+	}
+	if err := c.Add(ctx, &detail, nil); err != nil {
+		return "", err
+	}
+
+	return detail.ID, nil
+}
+
 func (d *domain) Transfer(
 	ctx context.Context,
 	domainName, authCode, customerID, regContactID, adminContactID, techContactID, billingContactID, invoiceOption string,
@@ -239,15 +458,11 @@ func (d *domain) Transfer(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result RegisterResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -270,15 +485,11 @@ func (d *domain) ValidatingTransferRequest(ctx context.Context, domainName strin
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return false, err
-		}
-		return false, errors.New(strings.ToLower(errResponse.Message))
+		return false, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result bool
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return false, err
 	}
 
@@ -315,41 +526,137 @@ func (d *domain) Renew(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return nil
 }
 
-func (d *domain) SearchOrders(ctx context.Context, criteria OrderCriteria) error {
+func (d *domain) PreviewRenewal(ctx context.Context, orderID string, years int) (*RenewalPreview, error) {
+	if !core.RgxNumber.MatchString(orderID) {
+		return nil, core.ErrRcInvalidCredential
+	}
+	if years < 1 || years > 10 {
+		return nil, core.NewValidationError("years must be in range of 1 to 10")
+	}
+
+	orderDetail, err := d.GetRegistrationOrderDetails(ctx, orderID, []string{"OrderDetails"})
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := pricing.New(d.core).GettingCustomerPricing(ctx, orderDetail.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+
+	pricePerYear, ok := prices[orderDetail.ProductKey][pricingActionRenew][strconv.Itoa(years)]
+	if !ok {
+		return nil, &core.APIError{Message: fmt.Sprintf("no renewal price of %s for %d year(s)", orderDetail.ProductKey, years)}
+	}
+
+	expiry := orderDetail.EndTime.ToTime()
+
+	return &RenewalPreview{
+		OrderID:           orderDetail.OrderID,
+		DomainName:        orderDetail.DomainName,
+		Years:             years,
+		TimeExpiryCurrent: expiry,
+		TimeExpiryRenewed: expiry.AddDate(years, 0, 0),
+		PricePerYear:      pricePerYear,
+		Price:             pricePerYear * float64(years),
+	}, nil
+}
+
+func (d *domain) SearchOrders(ctx context.Context, criteria OrderCriteria, offset, limit uint16) (*OrderSearchResult, error) {
+	if limit < 10 || limit > 500 {
+		return nil, core.NewValidationError("limit must be in range of 10 to 500")
+	}
+	if offset <= 0 {
+		return nil, core.NewValidationError("offset must greater than 0")
+	}
+
 	urlValues, err := criteria.URLValues()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	urlValues.Add("no-of-records", strconv.FormatUint(uint64(limit), 10))
+	urlValues.Add("page-no", strconv.FormatUint(uint64(offset), 10))
+
 	resp, err := d.core.CallAPI(ctx, http.MethodGet, "domains", "search", urlValues)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	bytesResp, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	replacer := strings.NewReplacer("orders.", "", "entity.", "", "entitytype.", "")
+	strResp := replacer.Replace(string(bytesResp))
+
+	var buffer map[string]core.JSONBytes
+	if err := json.Unmarshal([]byte(strResp), &buffer); err != nil {
+		return nil, core.NewDecodeError(err)
+	}
+
+	orders := make([]OrderSummary, len(buffer))
+	var numOrders, numMatched int
+	for key, dataBytes := range buffer {
+		switch {
+		case core.RgxNumber.MatchString(key):
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 1 || idx > len(orders) {
+				continue
+			}
+			// OrderSummary holds only part of an order row, so it is decoded leniently even with strict decoding.
+			if err := json.Unmarshal(dataBytes, &orders[idx-1]); err != nil {
+				return nil, core.NewDecodeError(err)
+			}
+			numOrders++
+		case key == "recsindb":
+			numMatched, err = strconv.Atoi(string(dataBytes))
+			if err != nil {
+				numMatched = 0
+			}
 		}
-		return errors.New(strings.ToLower(errResponse.Message))
 	}
 
-	return nil
+	return &OrderSearchResult{
+		RequestedLimit:  limit,
+		RequestedOffset: offset,
+		Orders:          orders[:numOrders],
+		TotalMatched:    numMatched,
+	}, nil
+}
+
+func (d *domain) ListByCustomer(
+	ctx context.Context,
+	customerID string,
+	filter DomainListFilter,
+	pageNo, perPage uint16,
+) (*OrderSearchResult, error) {
+	if !core.RgxNumber.MatchString(customerID) {
+		return nil, core.ErrRcInvalidCredential
+	}
+
+	criteria := OrderCriteria{
+		Criteria: core.Criteria{
+			CustomerIDs: []string{customerID},
+		},
+		Statuses:        filter.Statuses,
+		TimeExpiryStart: filter.TimeExpiryStart,
+		TimeExpiryEnd:   filter.TimeExpiryEnd,
+		SortOrderBy:     filter.SortOrderBy,
+	}
+
+	return d.SearchOrders(ctx, criteria, pageNo, perPage)
 }
 
 func (d *domain) GetCustomerDefaultNameServers(ctx context.Context, customerID string) ([]string, error) {
@@ -368,15 +675,11 @@ func (d *domain) GetCustomerDefaultNameServers(ctx context.Context, customerID s
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	result := make([]string, 0)
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -399,16 +702,45 @@ func (d *domain) GetOrderID(ctx context.Context, domainName string) (string, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return "", err
-		}
-		return "", errors.New(strings.ToLower(errResponse.Message))
+		return "", core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return string(bytesResp), nil
 }
 
+// GetOrderIDs returns the IDs of every order placed for the domain name, including
+// deleted and archived ones, oldest first.
+func (d *domain) GetOrderIDs(ctx context.Context, domainName string) ([]string, error) {
+	criteria := OrderCriteria{
+		DomainName: domainName,
+		Statuses: []core.EntityStatus{
+			core.StatusActive,
+			core.StatusInActive,
+			core.StatusSuspended,
+			core.StatusRestorable,
+			core.StatusDeleted,
+			core.StatusArchived,
+		},
+		SortOrderBy: []SortOrder{{SortByCreationTime: false}},
+	}
+
+	orderIDs := make([]string, 0)
+	for pageNo := uint16(1); ; pageNo++ {
+		result, err := d.SearchOrders(ctx, criteria, pageNo, maxSearchRecords)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range result.Orders {
+			orderIDs = append(orderIDs, result.Orders[i].OrderID)
+		}
+
+		if len(result.Orders) == 0 || len(orderIDs) >= result.TotalMatched {
+			return orderIDs, nil
+		}
+	}
+}
+
 func (d *domain) GetRegistrationOrderDetails(ctx context.Context, orderID string, options []string) (*OrderDetail, error) {
 	data := make(url.Values)
 	data.Add("order-id", orderID)
@@ -426,21 +758,108 @@ func (d *domain) GetRegistrationOrderDetails(ctx context.Context, orderID string
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var orderDetail OrderDetail
-	if err := json.Unmarshal(bytesResp, &orderDetail); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &orderDetail); err != nil {
 		return nil, err
 	}
 
 	return &orderDetail, nil
 }
 
+// GetDetailsByDomains resolves the order of each domain and fetches its details concurrently.
+// The details fetched are returned along with a core.BatchError keyed by the domain names which failed.
+func (d *domain) GetDetailsByDomains(
+	ctx context.Context,
+	domainNames []string,
+	options []OrderDetailOption,
+) (map[string]*OrderDetail, error) {
+	strOptions := make([]string, 0, len(options))
+	for _, option := range options {
+		strOptions = append(strOptions, string(option))
+	}
+
+	return core.RunBatch(ctx, domainNames, func(ctx context.Context, domainName string) (*OrderDetail, error) {
+		return d.getDetailsByDomain(ctx, domainName, strOptions)
+	})
+}
+
+func (d *domain) getDetailsByDomain(ctx context.Context, domainName string, options []string) (*OrderDetail, error) {
+	orderID, err := d.GetOrderID(ctx, domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.GetRegistrationOrderDetails(ctx, orderID, options)
+}
+
+func (d *domain) GetRegistrantDetails(ctx context.Context, orderID string) (*RegistrantSnapshot, error) {
+	if !core.RgxNumber.MatchString(orderID) {
+		return nil, core.ErrRcInvalidCredential
+	}
+
+	data := make(url.Values)
+	data.Add("order-id", orderID)
+	data.Add("options", string(OrderDetailRegistrantContactDetails))
+
+	resp, err := d.core.CallAPI(ctx, http.MethodGet, "domains", "details", data)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	// Only the registrant section is decoded, the rest of the details is ignored even with strict decoding.
+	var details struct {
+		RegistrantContact json.RawMessage `json:"registrantcontact"`
+	}
+	if err := json.Unmarshal(bytesResp, &details); err != nil {
+		return nil, core.NewDecodeError(err)
+	}
+	if len(details.RegistrantContact) == 0 || string(details.RegistrantContact) == "null" {
+		return nil, &core.APIError{StatusCode: resp.StatusCode, Message: "missing registrant contact"}
+	}
+
+	var snapshot RegistrantSnapshot
+	if err := json.Unmarshal(details.RegistrantContact, &snapshot); err != nil {
+		return nil, core.NewDecodeError(err)
+	}
+	snapshot.OrderID = orderID
+	snapshot.Raw = details.RegistrantContact
+
+	return &snapshot, nil
+}
+
+// GetOrderContacts fetches only the contact related options of the order details.
+func (d *domain) GetOrderContacts(ctx context.Context, orderID string) (*OrderContacts, error) {
+	orderDetail, err := d.GetRegistrationOrderDetails(ctx, orderID, orderContactOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderContacts{
+		OrderID:             orderID,
+		RegistrantContactID: orderDetail.RegistrantContactID,
+		AdminContactID:      orderDetail.AdminContactID,
+		TechContactID:       orderDetail.TechContactID,
+		BillingContactID:    orderDetail.BillingContactID,
+		RegistrantContact:   orderDetail.RegistrantContact,
+		AdminContact:        orderDetail.Admincontact,
+		TechContact:         orderDetail.TechContact,
+		BillingContact:      orderDetail.BillingContact,
+	}, nil
+}
+
 func (d *domain) ModifyNameServers(ctx context.Context, orderID string, ns []string) (*NameServersResponse, error) {
 	data := make(url.Values)
 	data.Add("order-id", orderID)
@@ -458,15 +877,11 @@ func (d *domain) ModifyNameServers(ctx context.Context, orderID string, ns []str
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result NameServersResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -491,15 +906,11 @@ func (d *domain) AddChildNameServer(ctx context.Context, orderID, cns string, ip
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result NameServersResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -524,15 +935,11 @@ func (d *domain) ModifyChildNameServerHostName(ctx context.Context, orderID, old
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result NameServersResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -558,15 +965,11 @@ func (d *domain) ModifyChildNameServerIPAddress(ctx context.Context, orderID, cn
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result NameServersResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -591,21 +994,24 @@ func (d *domain) DeletingChildNameServerIPAddress(ctx context.Context, orderID,
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result NameServersResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
+// ForcesSixtyDayLockOptOut reports whether the client was created WithForcedSixtyDayLockOptOut.
+func (d *domain) ForcesSixtyDayLockOptOut() bool {
+	return d.sixtyDayLockOptOut
+}
+
+// ModifyContacts opts out of the 60-day transfer lock when sixtyDayLockOptout is set
+// or the client was created WithForcedSixtyDayLockOptOut, which sixtyDayLockOptout cannot override.
 func (d *domain) ModifyContacts(
 	ctx context.Context,
 	orderID, regContactID, adminContactID, techContactID, billingContactID string,
@@ -618,7 +1024,7 @@ func (d *domain) ModifyContacts(
 	data.Add("admin-contact-id", adminContactID)
 	data.Add("tech-contact-id", techContactID)
 	data.Add("billing-contact-id", billingContactID)
-	data.Add("sixty-day-lock-optout", strconv.FormatBool(sixtyDayLockOptout))
+	data.Add("sixty-day-lock-optout", strconv.FormatBool(sixtyDayLockOptout || d.sixtyDayLockOptOut))
 	data.Add("designated-agent", strconv.FormatBool(designatedAgent))
 	data.Add("attr-name", attrName)
 	data.Add("attr-value", attrValue)
@@ -635,15 +1041,11 @@ func (d *domain) ModifyContacts(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result ModifyAuthCodeResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -673,15 +1075,11 @@ func (d *domain) ModifyPrivacyProtectionStatus(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result ModifyPrivacyProtectionStatusResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -705,15 +1103,11 @@ func (d *domain) ModifyAuthCode(ctx context.Context, orderID, authCode string) (
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result ModifyAuthCodeResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -736,15 +1130,11 @@ func (d *domain) ApplyTheftProtectionLock(ctx context.Context, orderID string) (
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result TheftProtectionLockResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -767,15 +1157,11 @@ func (d *domain) RemoveTheftProtectionLock(ctx context.Context, orderID string)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result TheftProtectionLockResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -786,6 +1172,11 @@ func (d *domain) GetTheListOfLocksAppliedOnDomainName(
 	ctx context.Context,
 	orderID string,
 ) (*GetTheListOfLocksAppliedOnDomainNameResponse, error) {
+	return d.fetchLocks(ctx, orderID)
+}
+
+// fetchLocks is the single decoder of domains/locks, so every lock query reads the lowercased keys.
+func (d *domain) fetchLocks(ctx context.Context, orderID string) (*GetTheListOfLocksAppliedOnDomainNameResponse, error) {
 	data := make(url.Values)
 	data.Add("order-id", orderID)
 
@@ -801,26 +1192,56 @@ func (d *domain) GetTheListOfLocksAppliedOnDomainName(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result GetTheListOfLocksAppliedOnDomainNameResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
-func (d *domain) ModifyTELWhoisPreference(ctx context.Context, orderID, whoisType, publish string) error {
+func (d *domain) ListLocks(ctx context.Context, orderID string) ([]Lock, error) {
+	locks, err := d.fetchLocks(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return locks.List()
+}
+
+func (d *domain) GetRegistrantLock(ctx context.Context, orderID string) (*RegistrantLock, error) {
+	locks, err := d.fetchLocks(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return locks.RegistrantLock()
+}
+
+func (d *domain) IsTheftProtectionLocked(ctx context.Context, orderID string) (bool, error) {
+	locks, err := d.fetchLocks(ctx, orderID)
+	if err != nil {
+		return false, err
+	}
+
+	return locks.TransferLock(), nil
+}
+
+func (d *domain) ModifyTELWhoisPreference(ctx context.Context, orderID string, whoisType TELWhoisType, publish TELPublish) error {
+	if !whoisType.IsValid() {
+		return core.NewValidationError("invalid tel whois type")
+	}
+	if !publish.IsValid() {
+		return core.NewValidationError("invalid tel publish preference")
+	}
+
 	data := make(url.Values)
 	data.Add("order-id", orderID)
-	data.Add("whois-type", whoisType)
-	data.Add("publish", publish)
+	data.Add("whois-type", string(whoisType))
+	data.Add("publish", string(publish))
 
 	resp, err := d.core.CallAPI(ctx, http.MethodPost, "domains", "tel/modify-whois-pref", data)
 	if err != nil {
@@ -834,16 +1255,24 @@ func (d *domain) ModifyTELWhoisPreference(ctx context.Context, orderID, whoisTyp
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return nil
 }
 
+func (d *domain) GetTELWhoisPreference(ctx context.Context, orderID string) (*TELWhoisPreference, error) {
+	orderDetail, err := d.GetRegistrationOrderDetails(ctx, orderID, []string{"OrderDetails"})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TELWhoisPreference{
+		WhoisType: orderDetail.TELWhoisType,
+		Publish:   orderDetail.TELPublish,
+	}, nil
+}
+
 func (d *domain) ResendTransferApprovalMail(ctx context.Context, orderID string) error {
 	data := make(url.Values)
 	data.Add("order-id", orderID)
@@ -860,11 +1289,7 @@ func (d *domain) ResendTransferApprovalMail(ctx context.Context, orderID string)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return nil
@@ -887,11 +1312,7 @@ func (d *domain) ReleaseUKDomainName(ctx context.Context, orderID, newTag string
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return nil
@@ -913,15 +1334,42 @@ func (d *domain) CancelTransfer(ctx context.Context, orderID string) (*CancelTra
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result CancelTransferResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (d *domain) CancelAction(ctx context.Context, eaqID string) (*CancelResponse, error) {
+	if !core.RgxNumber.MatchString(eaqID) {
+		return nil, core.ErrRcInvalidCredential
+	}
+
+	data := make(url.Values)
+	data.Add("eaq-id", eaqID)
+
+	resp, err := d.core.CallAPI(ctx, http.MethodPost, "actions", "cancel", data)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	var result CancelResponse
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -945,15 +1393,11 @@ func (d *domain) Suspend(ctx context.Context, orderID, reason string) (*TheftPro
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result TheftProtectionLockResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -976,15 +1420,11 @@ func (d *domain) Unsuspend(ctx context.Context, orderID string) (*TheftProtectio
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result TheftProtectionLockResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -1007,15 +1447,11 @@ func (d *domain) Delete(ctx context.Context, orderID string) (*DeleteResponse, e
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result DeleteResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -1039,40 +1475,44 @@ func (d *domain) Restore(ctx context.Context, orderID, invoiceOption string) err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return nil
 }
 
-func (d *domain) RecheckingNSWithDERegistry(ctx context.Context, orderID string) error {
+func (d *domain) RecheckingNSWithDERegistry(ctx context.Context, orderID string) (*DENICCheckResult, error) {
 	data := make(url.Values)
 	data.Add("order-id", orderID)
 
+	// A failed check comes with a Failed status, which CallAPI reports as an APIError along with
+	// the response. Its body still holds the per name server results, so it is decoded too.
+	var checkErr *core.APIError
 	resp, err := d.core.CallAPI(ctx, http.MethodPost, "domains", "de/recheck-ns", data)
-	if err != nil {
-		return err
+	if err != nil && (!errors.As(err, &checkErr) || resp == nil) {
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	bytesResp, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
-	return nil
+	var result DENICCheckResult
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
+		return nil, err
+	}
+
+	if checkErr != nil && len(result.NameServers) == 0 {
+		return nil, checkErr
+	}
+
+	return &result, nil
 }
 
 func (d *domain) AssociatingOrDissociatingXXXMembershipTokenID(ctx context.Context, orderID, associationID string) error {
@@ -1092,11 +1532,7 @@ func (d *domain) AssociatingOrDissociatingXXXMembershipTokenID(ctx context.Conte
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return err
-		}
-		return errors.New(strings.ToLower(errResponse.Message))
+		return core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	return nil
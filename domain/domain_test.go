@@ -21,10 +21,11 @@ var (
 	orderID    = os.Getenv("TEST_ORDER_ID")
 	_          = os.Getenv("TEST_CNS") // cns
 	authCode   = os.Getenv("TEST_AUTH_CODE")
+	customerID = os.Getenv("TEST_CUSTOMER_ID")
 )
 
 func TestSuggestNames(t *testing.T) {
-	res, err := d.SuggestNames(context.Background(), "domain", "", false, false)
+	res, err := d.SuggestNames(context.Background(), "domain", SuggestNamesOptions{AddRelated: true})
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
@@ -35,6 +36,12 @@ func TestGetOrderID(t *testing.T) {
 	require.NotNil(t, res)
 }
 
+func TestGetOrderIDs(t *testing.T) {
+	res, err := d.GetOrderIDs(context.Background(), domainName)
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+}
+
 func TestGetRegistrationOrderDetails(t *testing.T) {
 	res, err := d.GetRegistrationOrderDetails(context.Background(), orderID, []string{"All"})
 	require.NoError(t, err)
@@ -80,3 +87,35 @@ func TestGetTheListOfLocksAppliedOnDomainName(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func TestGetRegistrantLock(t *testing.T) {
+	res, err := d.GetRegistrantLock(context.Background(), orderID)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestIsTheftProtectionLocked(t *testing.T) {
+	_, err := d.IsTheftProtectionLocked(context.Background(), orderID)
+	require.NoError(t, err)
+}
+
+func TestListByCustomer(t *testing.T) {
+	res, err := d.ListByCustomer(context.Background(), customerID, DomainListFilter{
+		Statuses: []core.EntityStatus{core.StatusActive},
+	}, 1, 10)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestGetOrderContacts(t *testing.T) {
+	res, err := d.GetOrderContacts(context.Background(), orderID)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.RegistrantContactID)
+}
+
+func TestGetRegistrantDetails(t *testing.T) {
+	res, err := d.GetRegistrantDetails(context.Background(), orderID)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.ContactID)
+	require.NotEmpty(t, res.Raw)
+}
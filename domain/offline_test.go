@@ -0,0 +1,288 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/internal/coretest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifyContactsSixtyDayLockOptOut(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"status":"Success"}`
+	})
+
+	dom := New(c)
+	require.False(t, dom.ForcesSixtyDayLockOptOut())
+	_, err := dom.ModifyContacts(context.Background(), "1", "2", "2", "2", "2", false, false, "", "")
+	require.NoError(t, err)
+
+	dom = New(c, WithForcedSixtyDayLockOptOut())
+	require.True(t, dom.ForcesSixtyDayLockOptOut())
+	_, err = dom.ModifyContacts(context.Background(), "1", "2", "2", "2", "2", false, false, "", "")
+	require.NoError(t, err)
+
+	calls := transport.Calls("domains/modify-contact")
+	require.Len(t, calls, 2)
+	require.Equal(t, "false", calls[0].Get("sixty-day-lock-optout"))
+	require.Equal(t, "true", calls[1].Get("sixty-day-lock-optout"))
+}
+
+func TestQuickRegisterValidation(t *testing.T) {
+	c, _ := coretest.New(core.Config{}, nil)
+	dom := New(c)
+
+	_, err := dom.QuickRegister(context.Background(), QuickRegisterRequest{DomainName: "example", CustomerID: "12345"})
+	require.ErrorAs(t, err, new(*core.ValidationError))
+
+	_, err = dom.QuickRegister(context.Background(), QuickRegisterRequest{DomainName: "example.com"})
+	require.ErrorIs(t, err, core.ErrRcInvalidCredential)
+}
+
+func TestQuickRegister(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(api string, _ url.Values) (int, string) {
+		switch api {
+		case "domains/available":
+			return http.StatusOK, `{"example.com":{"classkey":"domcno","status":"available"}}`
+		case "contacts/details":
+			return http.StatusOK, `{"contactid":"2","customerid":"12345"}`
+		case "domains/register":
+			return http.StatusOK, `{"entityid":"3","status":"Success","sellingamount":"-9.99"}`
+		}
+		return http.StatusNotFound, `{"status":"ERROR","message":"unexpected call"}`
+	})
+
+	res, err := New(c).QuickRegister(context.Background(), QuickRegisterRequest{
+		DomainName:  "Example.COM",
+		CustomerID:  "12345",
+		ContactID:   "2",
+		NameServers: []string{"ns1.example.net"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "3", res.EntityID)
+
+	calls := transport.Calls("domains/register")
+	require.Len(t, calls, 1)
+	require.Equal(t, "example.com", calls[0].Get("domain-name"))
+	require.Equal(t, InvoicePayInvoice, calls[0].Get("invoice-option"))
+}
+
+func TestSetRenewalPreferencesValidation(t *testing.T) {
+	c, _ := coretest.New(core.Config{}, nil)
+	dom := New(c)
+
+	_, err := dom.SetRenewalPreferences(context.Background(), "12345", RenewalPreferences{AutoRenew: true, Years: 11})
+	require.ErrorAs(t, err, new(*core.ValidationError))
+
+	_, err = dom.SetRenewalPreferences(context.Background(), "order", RenewalPreferences{AutoRenew: true})
+	require.ErrorIs(t, err, core.ErrRcInvalidCredential)
+}
+
+func TestSetRenewalPreferencesDefaults(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"status":"Success"}`
+	})
+
+	_, err := New(c).SetRenewalPreferences(context.Background(), "12345", RenewalPreferences{AutoRenew: true})
+	require.NoError(t, err)
+
+	calls := transport.Calls("domains/modify-auto-renew")
+	require.Len(t, calls, 1)
+	require.Equal(t, "1", calls[0].Get("auto-renew-years"))
+	require.Equal(t, InvoicePayInvoice, calls[0].Get("invoice-option"))
+}
+
+func TestGetOrderIDsPaging(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(api string, data url.Values) (int, string) {
+		first := 1
+		count := 500
+		if data.Get("page-no") == "2" {
+			first, count = 501, 1
+		}
+
+		rows := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			rows = append(rows, fmt.Sprintf(`"%d":{"orders.orderid":"%d"}`, i+1, first+i))
+		}
+
+		return http.StatusOK, `{"recsonpage":"` + strconv.Itoa(count) + `","recsindb":"501",` + strings.Join(rows, ",") + `}`
+	})
+
+	orderIDs, err := New(c).GetOrderIDs(context.Background(), "example.com")
+	require.NoError(t, err)
+	require.Len(t, orderIDs, 501)
+	require.Len(t, transport.Calls("domains/search"), 2)
+}
+
+func TestCheckAvailabilityBulkPartialError(t *testing.T) {
+	c, _ := coretest.New(core.Config{}, func(_ string, data url.Values) (int, string) {
+		tld := data.Get("tlds")
+		if tld == "net" {
+			return http.StatusInternalServerError, `{"status":"ERROR","message":"Registry timeout"}`
+		}
+
+		rows := make([]string, 0, len(data["domain-name"]))
+		for _, name := range data["domain-name"] {
+			rows = append(rows, `"`+name+`.`+tld+`":{"classkey":"domcno","status":"available"}`)
+		}
+		return http.StatusOK, "{" + strings.Join(rows, ",") + "}"
+	})
+
+	names := make([]string, 0, AvailabilityChunkSize+1)
+	for i := 0; i <= AvailabilityChunkSize; i++ {
+		names = append(names, "name"+strconv.Itoa(i))
+	}
+
+	availabilities, err := New(c).CheckAvailabilityBulk(context.Background(), names, []string{"com", "net"})
+	require.Len(t, availabilities, AvailabilityChunkSize+1)
+	require.Equal(t, DomRegUnregistered, availabilities["name0.com"].Status)
+	require.Equal(t, DomRegUnregistered, availabilities["name20.com"].Status)
+
+	var partialErr *PartialError
+	require.ErrorAs(t, err, &partialErr)
+	require.Len(t, partialErr.Failed, AvailabilityChunkSize+1)
+	require.Contains(t, partialErr.Failed, "name0.net")
+	require.Contains(t, partialErr.Failed, "name20.net")
+	require.NotContains(t, partialErr.Failed, "name0.com")
+	require.ErrorAs(t, err, new(*core.APIError))
+}
+
+func TestRecheckingNSWithDERegistryFailed(t *testing.T) {
+	c, _ := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{
+			"status": "Failed",
+			"message": "Nameserver check failed",
+			"ns1.example.de": {"status": "Failed", "errors": ["Timeout"]},
+			"ns2.example.de": {"status": "Success"}
+		}`
+	})
+
+	res, err := New(c).RecheckingNSWithDERegistry(context.Background(), "1")
+	require.NoError(t, err)
+	require.False(t, res.IsPassed)
+	require.Equal(t, "Nameserver check failed", res.Message)
+	require.Len(t, res.NameServers, 2)
+	require.Equal(t, []string{"Timeout"}, res.Failed()[0].Messages)
+
+	c, _ = coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"status": "ERROR", "message": "Order not found"}`
+	})
+
+	_, err = New(c).RecheckingNSWithDERegistry(context.Background(), "1")
+	var apiErr *core.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "order not found", apiErr.Error())
+}
+
+func TestLocksQueriesShareDecoding(t *testing.T) {
+	c, _ := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{
+			"clientTransferProhibited": true,
+			"sixtyDayLock": {"lockerid":"1","addedby":"Registry","reason":"change of registrant","creationdt":"1700000000"}
+		}`
+	})
+	dom := New(c)
+
+	isLocked, err := dom.IsTheftProtectionLocked(context.Background(), "1")
+	require.NoError(t, err)
+	require.True(t, isLocked)
+
+	registrantLock, err := dom.GetRegistrantLock(context.Background(), "1")
+	require.NoError(t, err)
+	require.True(t, registrantLock.IsLocked)
+	require.Equal(t, time.Unix(1700000000, 0).Add(RegistrantLockPeriod), registrantLock.TimeExpiry)
+
+	locks, err := dom.ListLocks(context.Background(), "1")
+	require.NoError(t, err)
+	require.Len(t, locks, 2)
+	require.Equal(t, LockClientTransferProhibited, locks[0].Type)
+	require.Equal(t, LockRegistrant, locks[1].Type)
+}
+
+func TestSuggestNamesOptions(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"domain.com":{"status":"available","in_ga":"true","score":"0.9","spin":""}}`
+	})
+
+	res, err := New(c).SuggestNames(context.Background(), "domain", SuggestNamesOptions{TLDOnly: "com", AddRelated: true})
+	require.NoError(t, err)
+	require.Contains(t, res, "domain.com")
+
+	calls := transport.Calls("domains/v5/suggest-names")
+	require.Len(t, calls, 1)
+	require.Equal(t, "com", calls[0].Get("tld-only"))
+	require.Equal(t, "true", calls[0].Get("add-related"))
+	require.Equal(t, "false", calls[0].Get("exact-match"))
+}
+
+func TestPreviewRenewal(t *testing.T) {
+	c, _ := coretest.New(core.Config{}, func(api string, _ url.Values) (int, string) {
+		switch api {
+		case "domains/details":
+			return http.StatusOK, `{"orderid":"12345","domainname":"example.com","customerid":"678","productkey":"domcno","endtime":"1735689600"}`
+		case "products/customer-price":
+			return http.StatusOK, `{"domcno":{"renewdomain":{"1":10.99,"2":10.49}}}`
+		}
+		return http.StatusNotFound, `{"status":"ERROR","message":"unexpected call"}`
+	})
+	dom := New(c)
+
+	preview, err := dom.PreviewRenewal(context.Background(), "12345", 2)
+	require.NoError(t, err)
+	require.Equal(t, "example.com", preview.DomainName)
+	require.Equal(t, time.Unix(1735689600, 0), preview.TimeExpiryCurrent)
+	require.Equal(t, time.Unix(1735689600, 0).AddDate(2, 0, 0), preview.TimeExpiryRenewed)
+	require.InDelta(t, 10.49, preview.PricePerYear, 0.001)
+	require.InDelta(t, 20.98, preview.Price, 0.001)
+
+	_, err = dom.PreviewRenewal(context.Background(), "12345", 3)
+	require.ErrorAs(t, err, new(*core.APIError))
+
+	_, err = dom.PreviewRenewal(context.Background(), "example.com", 1)
+	require.ErrorIs(t, err, core.ErrRcInvalidCredential)
+}
+
+func TestGetTELWhoisPreference(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"orderid":"12345","domainname":"example.tel","whoistype":"Legal","publish":"n"}`
+	})
+
+	pref, err := New(c).GetTELWhoisPreference(context.Background(), "12345")
+	require.NoError(t, err)
+	require.Equal(t, &TELWhoisPreference{WhoisType: TELWhoisLegal, Publish: TELPublishNo}, pref)
+	require.True(t, pref.WhoisType.IsValid())
+	require.Equal(t, "12345", transport.Calls("domains/details")[0].Get("order-id"))
+}
+
+func TestCancelAction(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(_ string, data url.Values) (int, string) {
+		if data.Get("eaq-id") == "999" {
+			return http.StatusOK, `{"status":"ERROR","message":"Action cannot be cancelled"}`
+		}
+		return http.StatusOK, `{"status":"Success","eaqid":"` + data.Get("eaq-id") + `","entityid":"12345","actionstatus":"Cancelled"}`
+	})
+	dom := New(c)
+
+	res, err := dom.CancelAction(context.Background(), "777")
+	require.NoError(t, err)
+	require.Equal(t, "Cancelled", res.ActionStatus)
+	require.Equal(t, "777", res.EaqID)
+	require.Len(t, transport.Calls("actions/cancel"), 1)
+
+	_, err = dom.CancelAction(context.Background(), "999")
+	var apiErr *core.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "action cannot be cancelled", apiErr.Error())
+
+	_, err = dom.CancelAction(context.Background(), "eaq")
+	require.ErrorIs(t, err, core.ErrRcInvalidCredential)
+	require.Len(t, transport.Calls("actions/cancel"), 2)
+}
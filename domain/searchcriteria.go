@@ -22,7 +22,7 @@ type OrderCriteria struct {
 	PrivacyStatus   PrivacyState        `validate:"omitempty" query:"privacy-enabled,omitempty"`
 	ShowChildOrders bool                `validate:"omitempty" query:"show-child-orders,omitempty"`
 	TimeExpiryStart time.Time           `validate:"omitempty" query:"expiry-date-start,omitempty"`
-	TimeExpiryEnd   time.Time           `validate:"omitempty" query:"expiry-date-start,omitempty"`
+	TimeExpiryEnd   time.Time           `validate:"omitempty" query:"expiry-date-end,omitempty"`
 }
 
 // URLValues godoc
@@ -30,13 +30,14 @@ type OrderCriteria struct {
 //nolint:gocognit,gocyclo,funlen
 func (c OrderCriteria) URLValues() (url.Values, error) {
 	if err := validator.New().Struct(c); err != nil {
-		return url.Values{}, err
+		return url.Values{}, &core.ValidationError{Err: err}
 	}
 
 	wg := sync.WaitGroup{}
 	rwMutex := sync.RWMutex{}
 
 	urlValues := url.Values{}
+	var criteriaErr error
 	valueCriteria := reflect.ValueOf(c)
 	typeCriteria := reflect.TypeOf(c)
 
@@ -47,6 +48,21 @@ func (c OrderCriteria) URLValues() (url.Values, error) {
 			vField := valueCriteria.Field(idx)
 			tField := typeCriteria.Field(idx)
 			fieldTag := tField.Tag.Get("query")
+			if fieldTag == "" && vField.Type() == reflect.TypeOf(core.Criteria{}) {
+				coreCriteriaData, err := vField.Interface().(core.Criteria).URLValues()
+				if err != nil {
+					rwMutex.Lock()
+					criteriaErr = err
+					rwMutex.Unlock()
+					return
+				}
+				rwMutex.Lock()
+				for key, values := range coreCriteriaData {
+					urlValues[key] = append(urlValues[key], values...)
+				}
+				rwMutex.Unlock()
+				return
+			}
 			if fieldTag != "" {
 				if strings.HasSuffix(fieldTag, "omitempty") && vField.IsZero() {
 					return
@@ -108,5 +124,10 @@ func (c OrderCriteria) URLValues() (url.Values, error) {
 	}
 
 	wg.Wait()
+	// A search missing the core criteria, such as the customer, would match more than asked for.
+	if criteriaErr != nil {
+		return url.Values{}, criteriaErr
+	}
+
 	return urlValues, nil
 }
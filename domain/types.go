@@ -1,22 +1,48 @@
 package domain
 
-import "github.com/mrehanabbasi/go-logicboxes/core"
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mrehanabbasi/go-logicboxes/contact"
+	"github.com/mrehanabbasi/go-logicboxes/core"
+)
 
 type SortBy string
 
 type (
 	PrivacyState       string
 	RegistrationStatus string
+	LockType           string
+	TELWhoisType       string
+	TELPublish         string
 	SortOrder          map[SortBy]bool
+	Locks              map[LockType]json.RawMessage
+	LockSource         string
+	OrderDetailOption  string
 )
 
-type SuggestNames map[string]struct {
+type SuggestNames map[string]SuggestName
+
+type SuggestName struct {
 	Status string         `json:"status"`
 	InGa   core.JSONBool  `json:"in_ga"`
 	Score  core.JSONFloat `json:"score"`
 	Spin   string         `json:"spin"`
 }
 
+type SuggestNamesOptions struct {
+	// TLDOnly restricts the suggestions to the given tld.
+	TLDOnly    string
+	ExactMatch bool
+	Adult      bool
+	// AddRelated adds names built on terms related to the keyword, see SuggestNames.RelatedKeywords.
+	AddRelated bool
+}
+
 type RegisterResponse struct {
 	ActionTypeDesc          string            `json:"actiontypedesc"`
 	UnutilisedSellingAmount core.JSONFloat    `json:"unutilisedsellingamount"`
@@ -32,6 +58,39 @@ type RegisterResponse struct {
 	InvoiceID               string            `json:"invoiceid"`
 	SellingCurrencySymbol   string            `json:"sellingcurrencysymbol"`
 	ActionStatusDesc        string            `json:"actionstatusdesc"`
+	// ChargedAmount is the net amount invoiced for the order, after discount.
+	ChargedAmount float64 `json:"-"`
+	// AppliedDiscount is the discount accepted on the invoice, zero when it was rejected.
+	AppliedDiscount float64 `json:"-"`
+}
+
+// UnmarshalJSON computes the charged amount and applied discount. It decodes leniently, so
+// StrictDecoding does not apply to RegisterResponse.
+func (r *RegisterResponse) UnmarshalJSON(b []byte) error {
+	type registerResponse RegisterResponse
+	var res registerResponse
+	if err := json.Unmarshal(b, &res); err != nil {
+		return err
+	}
+
+	var invoice struct {
+		Discount       *core.JSONFloat `json:"discount"`
+		DiscountAmount *core.JSONFloat `json:"discountamount"`
+	}
+	if err := json.Unmarshal(b, &invoice); err != nil {
+		return err
+	}
+
+	*r = RegisterResponse(res)
+	r.ChargedAmount = math.Abs(r.SellingAmount.ToFloat64())
+	switch {
+	case invoice.DiscountAmount != nil:
+		r.AppliedDiscount = math.Abs(invoice.DiscountAmount.ToFloat64())
+	case invoice.Discount != nil:
+		r.AppliedDiscount = math.Abs(invoice.Discount.ToFloat64())
+	}
+
+	return nil
 }
 
 type Contact struct {
@@ -53,6 +112,30 @@ type Contact struct {
 	ZIP           string   `json:"zip"`
 }
 
+// RegistrantSnapshot is the registrant contact of an order as held for the registry, which
+// may differ from the current contact record. Raw holds the registrant section as returned.
+type RegistrantSnapshot struct {
+	OrderID          string          `json:"-"`
+	ContactID        string          `json:"contactid"`
+	Type             string          `json:"type"`
+	Status           string          `json:"contactstatus"`
+	Name             string          `json:"name"`
+	Company          string          `json:"company"`
+	Email            string          `json:"emailaddr"`
+	Address1         string          `json:"address1"`
+	Address2         string          `json:"address2"`
+	Address3         string          `json:"address3"`
+	City             string          `json:"city"`
+	State            string          `json:"state"`
+	Zip              string          `json:"zip"`
+	Country          string          `json:"country"`
+	PhoneCountryCode string          `json:"telnocc"`
+	Phone            string          `json:"telno"`
+	FaxCountryCode   string          `json:"faxnocc"`
+	Fax              string          `json:"faxno"`
+	Raw              json.RawMessage `json:"-"`
+}
+
 type OrderDetail struct {
 	Classkey          string          `json:"classkey"`
 	AllowDeletion     core.JSONBool   `json:"allowdeletion"`
@@ -117,6 +200,43 @@ type OrderDetail struct {
 	AdminContactID             string          `json:"admincontactid"`
 	IsOrderSuspendedUponExpiry core.JSONBool   `json:"isOrderSuspendedUponExpiry"`
 	IsPrivacyProtected         core.JSONBool   `json:"isprivacyprotected"`
+	TELWhoisType               TELWhoisType    `json:"whoistype,omitempty"`
+	TELPublish                 TELPublish      `json:"publish,omitempty"`
+}
+
+type RenewalPreview struct {
+	OrderID           string
+	DomainName        string
+	Years             int
+	TimeExpiryCurrent time.Time
+	TimeExpiryRenewed time.Time
+	PricePerYear      float64
+	Price             float64
+}
+
+type OrderSummary struct {
+	OrderID       string        `json:"orderid"`
+	DomainName    string        `json:"description"`
+	CustomerID    string        `json:"customerid"`
+	CurrentStatus string        `json:"currentstatus"`
+	ProductKey    string        `json:"entitytypekey"`
+	AutoRenew     core.JSONBool `json:"autorenew"`
+	CreationTime  core.JSONTime `json:"creationtime"`
+	EndTime       core.JSONTime `json:"endtime"`
+}
+
+type OrderSearchResult struct {
+	RequestedLimit  uint16
+	RequestedOffset uint16
+	TotalMatched    int
+	Orders          []OrderSummary
+}
+
+type DomainListFilter struct {
+	Statuses        []core.EntityStatus
+	TimeExpiryStart time.Time
+	TimeExpiryEnd   time.Time
+	SortOrderBy     []SortOrder
 }
 
 type NameServersResponse struct {
@@ -172,9 +292,70 @@ type TheftProtectionLockResponse struct {
 	ActionStatusDesc string `json:"actionstatusdesc"`
 }
 
+// GetTheListOfLocksAppliedOnDomainNameResponse holds the locks applied on a domain, keyed by their
+// lowercased name. Registrar locks and client statuses set at the registry are both reported.
 type GetTheListOfLocksAppliedOnDomainNameResponse struct {
-	TransferLock bool `json:"transferlock"`
-	CustomerLock bool `json:"customerlock"`
+	Locks Locks
+}
+
+type Lock struct {
+	Type   LockType
+	Source LockSource
+}
+
+type LockDetail struct {
+	LockerID     string        `json:"lockerid"`
+	AddedBy      string        `json:"addedby"`
+	Reason       string        `json:"reason"`
+	TimeCreation core.JSONTime `json:"creationdt"`
+}
+
+type RegistrantLock struct {
+	IsLocked     bool
+	Reason       string
+	TimeCreation time.Time
+	TimeExpiry   time.Time
+}
+
+type TELWhoisPreference struct {
+	WhoisType TELWhoisType
+	Publish   TELPublish
+}
+
+type StdResponse struct {
+	Status string `json:"status"`
+	Msg    string `json:"msg"`
+}
+
+type RenewalPreferences struct {
+	AutoRenew bool
+	// Years is the term of each automatic renewal, between 1 and 10.
+	Years         int
+	InvoiceOption string
+}
+
+type QuickRegisterRequest struct {
+	DomainName      string
+	Years           int
+	CustomerID      string
+	ContactID       string
+	Contact         *contact.Detail
+	NameServers     []string
+	InvoiceOption   string
+	PurchasePrivacy bool
+	AutoRenew       bool
+}
+
+type OrderContacts struct {
+	OrderID             string
+	RegistrantContactID string
+	AdminContactID      string
+	TechContactID       string
+	BillingContactID    string
+	RegistrantContact   Contact
+	AdminContact        Contact
+	TechContact         Contact
+	BillingContact      Contact
 }
 
 type CancelTransferResponse struct {
@@ -182,12 +363,85 @@ type CancelTransferResponse struct {
 	Message string `json:"message"`
 }
 
+type CancelResponse struct {
+	Status           string `json:"status"`
+	Message          string `json:"message"`
+	EaqID            string `json:"eaqid"`
+	EntityID         string `json:"entityid"`
+	ActionStatus     string `json:"actionstatus"`
+	ActionStatusDesc string `json:"actionstatusdesc"`
+}
+
 type DeleteResponse struct {
 	Status        string `json:"status"`
 	EaqID         string `json:"eaqid"`
 	CurrentAction string `json:"currentaction"`
 }
 
+const (
+	// RegistrantLockPeriod is the transfer lock period applied by gTLD registries after a change of registrant.
+	RegistrantLockPeriod = 60 * 24 * time.Hour
+
+	// pricingActionRenew is the action key of domain renewals in the customer pricing.
+	pricingActionRenew = "renewdomain"
+
+	// maxSearchRecords is the maximum number of records returned by a search page.
+	maxSearchRecords = 500
+)
+
+// Const for order details options.
+const (
+	OrderDetailAll                      OrderDetailOption = "All"
+	OrderDetailOrderDetails             OrderDetailOption = "OrderDetails"
+	OrderDetailContactIDs               OrderDetailOption = "ContactIds"
+	OrderDetailRegistrantContactDetails OrderDetailOption = "RegistrantContactDetails"
+	OrderDetailAdminContactDetails      OrderDetailOption = "AdminContactDetails"
+	OrderDetailTechContactDetails       OrderDetailOption = "TechContactDetails"
+	OrderDetailBillingContactDetails    OrderDetailOption = "BillingContactDetails"
+	OrderDetailNsDetails                OrderDetailOption = "NsDetails"
+	OrderDetailDomainStatus             OrderDetailOption = "DomainStatus"
+	OrderDetailDNSSECDetails            OrderDetailOption = "DNSSECDetails"
+	OrderDetailStatusDetails            OrderDetailOption = "StatusDetails"
+)
+
+// Const for invoice options.
+const (
+	InvoiceNoInvoice   = "NoInvoice"
+	InvoicePayInvoice  = "PayInvoice"
+	InvoiceKeepInvoice = "KeepInvoice"
+	InvoiceOnlyAdd     = "OnlyAdd"
+)
+
+// orderContactOptions are the order details options holding the contacts of an order.
+var orderContactOptions = []string{
+	"ContactIds",
+	"RegistrantContactDetails",
+	"AdminContactDetails",
+	"TechContactDetails",
+	"BillingContactDetails",
+}
+
+// RelatedKeywords returns the distinct second-level labels of the suggestions which differ from
+// the searched keyword. LogicBoxes does not mark which suggestions come from AddRelated, so this is
+// a heuristic: with AddRelated the labels include the related terms, but they are mixed with the
+// variations of the keyword, such as prefixed or suffixed names, which are returned in any case.
+func (s SuggestNames) RelatedKeywords(keyword string) []string {
+	keyword = strings.ToLower(keyword)
+	seen := map[string]bool{}
+	related := make([]string, 0)
+	for name := range s {
+		label, _, _ := strings.Cut(strings.ToLower(name), ".")
+		if label == "" || label == keyword || seen[label] {
+			continue
+		}
+		seen[label] = true
+		related = append(related, label)
+	}
+	sort.Strings(related)
+
+	return related
+}
+
 // Const for sort order.
 const (
 	SortByOrderID          SortBy = "orderid"
@@ -206,4 +460,200 @@ const (
 	DomRegUnregistered  RegistrationStatus = "available"
 	DomRegThroughUs     RegistrationStatus = "regthroughus"
 	DomRegThroughOthers RegistrationStatus = "regthroughothers"
+
+	LockTransfer   LockType = "transferlock"
+	LockCustomer   LockType = "customerlock"
+	LockRegistrant LockType = "sixtydaylock"
+	LockDelete     LockType = "deletelock"
+	LockUpdate     LockType = "updatelock"
+
+	LockClientTransferProhibited LockType = "clienttransferprohibited"
+	LockClientDeleteProhibited   LockType = "clientdeleteprohibited"
+	LockClientUpdateProhibited   LockType = "clientupdateprohibited"
+
+	LockSourceCustomer LockSource = "Customer"
+	LockSourceReseller LockSource = "Reseller"
+	LockSourceRegistry LockSource = "Registry"
+
+	TELWhoisNatural TELWhoisType = "Natural"
+	TELWhoisLegal   TELWhoisType = "Legal"
+
+	TELPublishYes TELPublish = "y"
+	TELPublishNo  TELPublish = "n"
 )
+
+func (t TELWhoisType) IsValid() bool {
+	return t == TELWhoisNatural || t == TELWhoisLegal
+}
+
+func (p TELPublish) IsValid() bool {
+	return p == TELPublishYes || p == TELPublishNo
+}
+
+// Has reports whether the lock is applied. A lock is reported either as a plain flag
+// or as an object holding its details.
+func (l Locks) Has(lockType LockType) bool {
+	raw, ok := l[lockType]
+	if !ok || string(raw) == "null" {
+		return false
+	}
+
+	var flag core.JSONBool
+	if err := json.Unmarshal(raw, &flag); err == nil {
+		return flag.ToBool()
+	}
+
+	return true
+}
+
+// Detail returns the details of the lock, or nil if the lock is not applied
+// or is reported without details.
+func (l Locks) Detail(lockType LockType) (*LockDetail, error) {
+	if !l.Has(lockType) {
+		return nil, nil
+	}
+
+	raw := l[lockType]
+	if len(raw) == 0 || raw[0] != '{' {
+		return nil, nil
+	}
+
+	var detail LockDetail
+	if err := json.Unmarshal(raw, &detail); err != nil {
+		return nil, err
+	}
+
+	return &detail, nil
+}
+
+// List returns the applied locks sorted by type. The source of a lock is read from
+// its details when reported, otherwise it is inferred from the lock type.
+func (l Locks) List() ([]Lock, error) {
+	list := make([]Lock, 0, len(l))
+	for lockType := range l {
+		if !l.Has(lockType) {
+			continue
+		}
+
+		detail, err := l.Detail(lockType)
+		if err != nil {
+			return nil, err
+		}
+
+		source := lockSourceOf(lockType)
+		if detail != nil {
+			if addedBy := parseLockSource(detail.AddedBy); addedBy != "" {
+				source = addedBy
+			}
+		}
+		list = append(list, Lock{Type: lockType, Source: source})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Type < list[j].Type
+	})
+
+	return list, nil
+}
+
+// IsRemovable reports whether the lock can be removed through the API, which is
+// not the case of locks imposed by the registry.
+func (l Lock) IsRemovable() bool {
+	return l.Source != LockSourceRegistry
+}
+
+func lockSourceOf(lockType LockType) LockSource {
+	switch {
+	case lockType == LockCustomer || lockType == LockTransfer:
+		return LockSourceCustomer
+	case lockType == LockDelete || lockType == LockUpdate,
+		strings.HasPrefix(string(lockType), "client"),
+		strings.Contains(strings.ToLower(string(lockType)), "reseller"):
+		return LockSourceReseller
+	default:
+		return LockSourceRegistry
+	}
+}
+
+func parseLockSource(addedBy string) LockSource {
+	for _, source := range []LockSource{LockSourceCustomer, LockSourceReseller, LockSourceRegistry} {
+		if strings.EqualFold(addedBy, string(source)) {
+			return source
+		}
+	}
+
+	return ""
+}
+
+// UnmarshalJSON keys the locks by their lowercased name. Any lock name is accepted, so
+// StrictDecoding does not apply to the locks response.
+func (r *GetTheListOfLocksAppliedOnDomainNameResponse) UnmarshalJSON(b []byte) error {
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	r.Locks = make(Locks, len(raw))
+	for key, val := range raw {
+		r.Locks[LockType(strings.ToLower(key))] = val
+	}
+
+	return nil
+}
+
+func (r *GetTheListOfLocksAppliedOnDomainNameResponse) TransferLock() bool {
+	return r.Locks.Has(LockTransfer) || r.Locks.Has(LockClientTransferProhibited)
+}
+
+func (r *GetTheListOfLocksAppliedOnDomainNameResponse) DeleteLock() bool {
+	return r.Locks.Has(LockDelete) || r.Locks.Has(LockClientDeleteProhibited)
+}
+
+func (r *GetTheListOfLocksAppliedOnDomainNameResponse) UpdateLock() bool {
+	return r.Locks.Has(LockUpdate) || r.Locks.Has(LockClientUpdateProhibited)
+}
+
+func (r *GetTheListOfLocksAppliedOnDomainNameResponse) CustomerLock() bool {
+	return r.Locks.Has(LockCustomer)
+}
+
+// List returns the active locks with their source.
+func (r *GetTheListOfLocksAppliedOnDomainNameResponse) List() ([]Lock, error) {
+	return r.Locks.List()
+}
+
+// RegistrantLock returns the 60-day lock applied after a change of registrant, if any.
+func (r *GetTheListOfLocksAppliedOnDomainNameResponse) RegistrantLock() (*RegistrantLock, error) {
+	result := RegistrantLock{
+		IsLocked: r.Locks.Has(LockRegistrant),
+	}
+
+	detail, err := r.Locks.Detail(LockRegistrant)
+	if err != nil {
+		return nil, err
+	}
+	if detail != nil {
+		result.Reason = detail.Reason
+		if creation := detail.TimeCreation.ToTime(); !creation.IsZero() {
+			result.TimeCreation = creation
+			result.TimeExpiry = creation.Add(RegistrantLockPeriod)
+		}
+	}
+
+	return &result, nil
+}
+
+// String lists the active locks, e.g. "customerlock, transferlock", or "no locks".
+func (r *GetTheListOfLocksAppliedOnDomainNameResponse) String() string {
+	active := make([]string, 0, len(r.Locks))
+	for lockType := range r.Locks {
+		if r.Locks.Has(lockType) {
+			active = append(active, string(lockType))
+		}
+	}
+	if len(active) == 0 {
+		return "no locks"
+	}
+	sort.Strings(active)
+
+	return strings.Join(active, ", ")
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocksHas(t *testing.T) {
+	locks := Locks{
+		LockTransfer:   json.RawMessage(`{"lockerid":"1","addedby":"Customer","reason":"theft protection"}`),
+		LockCustomer:   json.RawMessage(`false`),
+		LockRegistrant: json.RawMessage(`"true"`),
+	}
+
+	require.True(t, locks.Has(LockTransfer))
+	require.False(t, locks.Has(LockCustomer))
+	require.True(t, locks.Has(LockRegistrant))
+	require.False(t, locks.Has("resellerlock"))
+
+	detail, err := locks.Detail(LockTransfer)
+	require.NoError(t, err)
+	require.NotNil(t, detail)
+	require.Equal(t, "theft protection", detail.Reason)
+
+	detail, err = locks.Detail(LockRegistrant)
+	require.NoError(t, err)
+	require.Nil(t, detail)
+}
+
+func TestLocksList(t *testing.T) {
+	locks := Locks{
+		LockTransfer:   json.RawMessage(`{"lockerid":"1","addedby":"Reseller","reason":"theft protection"}`),
+		LockCustomer:   json.RawMessage(`false`),
+		LockRegistrant: json.RawMessage(`true`),
+	}
+
+	list, err := locks.List()
+	require.NoError(t, err)
+	require.Equal(t, []Lock{
+		{Type: LockRegistrant, Source: LockSourceRegistry},
+		{Type: LockTransfer, Source: LockSourceReseller},
+	}, list)
+	require.False(t, list[0].IsRemovable())
+	require.True(t, list[1].IsRemovable())
+}
+
+func TestLocksResponseAccessors(t *testing.T) {
+	var res GetTheListOfLocksAppliedOnDomainNameResponse
+	err := json.Unmarshal([]byte(`{
+		"customerlock": true,
+		"transferlock": false,
+		"clientDeleteProhibited": {"lockerid":"1","addedby":"Reseller"}
+	}`), &res)
+	require.NoError(t, err)
+	require.True(t, res.CustomerLock())
+	require.False(t, res.TransferLock())
+	require.True(t, res.DeleteLock())
+	require.False(t, res.UpdateLock())
+	require.Equal(t, "clientdeleteprohibited, customerlock", res.String())
+
+	require.Equal(t, "no locks", (&GetTheListOfLocksAppliedOnDomainNameResponse{}).String())
+}
+
+func TestOrderCriteriaURLValues(t *testing.T) {
+	criteria := OrderCriteria{
+		Criteria: core.Criteria{
+			CustomerIDs: []string{"1234"},
+		},
+		Statuses:   []core.EntityStatus{core.StatusActive, core.StatusSuspended},
+		DomainName: "example.com",
+	}
+
+	values, err := criteria.URLValues()
+	require.NoError(t, err)
+	require.Equal(t, []string{"1234"}, values["customer-id"])
+	require.ElementsMatch(t, []string{"Active", "Suspended"}, values["status"])
+	require.Equal(t, "example.com", values.Get("domain-name"))
+}
+
+func TestDENICCheckResultUnmarshal(t *testing.T) {
+	var res DENICCheckResult
+	err := json.Unmarshal([]byte(`{
+		"ns2.example.com": {"status": "Failed", "errors": ["Inconsistent set of NS RRs", "Timeout"]},
+		"ns1.example.com": {"status": "Success"}
+	}`), &res)
+	require.NoError(t, err)
+	require.False(t, res.IsPassed)
+	require.Len(t, res.NameServers, 2)
+	require.Equal(t, "ns1.example.com", res.NameServers[0].NameServer)
+	require.True(t, res.NameServers[0].IsPassed)
+
+	failed := res.Failed()
+	require.Len(t, failed, 1)
+	require.Equal(t, "ns2.example.com", failed[0].NameServer)
+	require.Equal(t, []string{"Inconsistent set of NS RRs", "Timeout"}, failed[0].Messages)
+}
+
+func TestRegisterResponseUnmarshal(t *testing.T) {
+	var res RegisterResponse
+	err := json.Unmarshal([]byte(`{
+		"sellingamount": "-8.50",
+		"discountamount": "1.50",
+		"entityid": "1",
+		"premiumdnsdetails": {"sellingamount": "-2.00"}
+	}`), &res)
+	require.NoError(t, err)
+	require.InDelta(t, 8.5, res.ChargedAmount, 0.001)
+	require.InDelta(t, 1.5, res.AppliedDiscount, 0.001)
+	require.NotNil(t, res.PremiumDNSDetails)
+	require.InDelta(t, 2.0, res.PremiumDNSDetails.ChargedAmount, 0.001)
+	require.Zero(t, res.PremiumDNSDetails.AppliedDiscount)
+}
+
+func TestSuggestNamesRelatedKeywords(t *testing.T) {
+	names := SuggestNames{
+		"domain.com":    {Status: "available"},
+		"domain.net":    {Status: "available"},
+		"mydomain.com":  {Status: "available"},
+		"Website.org":   {Status: "available"},
+		"website.com":   {Status: "available"},
+		".com":          {Status: "available"},
+		"hostname.asia": {Status: "available"},
+	}
+
+	require.Equal(t, []string{"hostname", "mydomain", "website"}, names.RelatedKeywords("Domain"))
+	require.Empty(t, SuggestNames{"domain.com": {}}.RelatedKeywords("domain"))
+}
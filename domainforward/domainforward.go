@@ -3,13 +3,10 @@ package domainforward
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
-	"strings"
 
 	"github.com/mrehanabbasi/go-logicboxes/core"
 )
@@ -72,15 +69,11 @@ func (d *domainForward) ActivatingDomainForwardingService(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -108,15 +101,11 @@ func (d *domainForward) GettingDetailsDomainForwardingService(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result DetailsDomainForward
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -152,15 +141,11 @@ func (d *domainForward) ManagingDomainForwardingService(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result StdResponse
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -183,15 +168,11 @@ func (d *domainForward) GettingDNSRecords(ctx context.Context, domainName string
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result []*DNSRecord
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -214,15 +195,11 @@ func (d *domainForward) RemoveDomainForwardingForDomain(ctx context.Context, dom
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return false, err
-		}
-		return false, errors.New(strings.ToLower(errResponse.Message))
+		return false, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result bool
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return false, err
 	}
 
@@ -246,15 +223,11 @@ func (d *domainForward) DisableDomainForwardingForSubDomain(ctx context.Context,
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return false, err
-		}
-		return false, errors.New(strings.ToLower(errResponse.Message))
+		return false, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result bool
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := d.core.Unmarshal(bytesResp, &result); err != nil {
 		return false, err
 	}
 
@@ -3,7 +3,6 @@ package general
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"io"
 	"net/http"
 	"net/url"
@@ -197,16 +196,11 @@ func fetchCurrencyDB(ctx context.Context, c core.Core) (currencyDB, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		err := json.Unmarshal(bytesResp, &errResponse)
-		if err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	ret := make(map[string]map[string]string)
-	if err := json.Unmarshal(bytesResp, &ret); err != nil {
+	if err := c.Unmarshal(bytesResp, &ret); err != nil {
 		return nil, err
 	}
 
@@ -235,3 +229,34 @@ func fetchCurrencyDB(ctx context.Context, c core.Core) (currencyDB, error) {
 
 	return cdb, nil
 }
+
+func fetchResellerCurrency(ctx context.Context, c core.Core) (CurrencyISO, error) {
+	resp, err := c.CallAPI(ctx, http.MethodGet, "billing", "reseller-balance", url.Values{})
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	// Only the currency is read from the balance, so it is decoded leniently even with strict decoding.
+	var balance struct {
+		SellingCurrency string `json:"sellingcurrencysymbol"`
+	}
+	if err := json.Unmarshal(bytesResp, &balance); err != nil {
+		return "", core.NewDecodeError(err)
+	}
+
+	if balance.SellingCurrency == "" {
+		return "", &core.APIError{StatusCode: resp.StatusCode, Message: "missing reseller selling currency"}
+	}
+
+	return CurrencyISO(strings.ToUpper(balance.SellingCurrency)), nil
+}
@@ -3,6 +3,8 @@ package general
 
 import (
 	"context"
+	"math"
+	"sync"
 
 	"github.com/mrehanabbasi/go-logicboxes/core"
 )
@@ -11,12 +13,66 @@ type general struct {
 	core       core.Core
 	currencies currencyDB
 	countries  countryDB
+	rates      map[CurrencyISO]float64
+	ratesMutex sync.RWMutex
+
+	resellerCurrencies    map[string]CurrencyISO
+	resellerCurrencyMutex sync.Mutex
 }
 
 type General interface {
 	CurrencyOf(iso CurrencyISO) Currency
 	CountryName(iso CountryISO) string
 	StatesOf(ctx context.Context, iso CountryISO) (States, error)
+	SupportedLanguages(ctx context.Context) ([]Language, error)
+	SetExchangeRate(iso CurrencyISO, rate float64) error
+	ExchangeRate(iso CurrencyISO) (float64, bool)
+	ResellerCurrency(ctx context.Context) (CurrencyISO, error)
+}
+
+// ResellerCurrency returns the selling currency of the reseller account, in which prices are
+// returned. It is fetched once per reseller scope of ctx, see core.WithReseller, and cached afterwards.
+func (g *general) ResellerCurrency(ctx context.Context) (CurrencyISO, error) {
+	resellerID, _ := core.ResellerFrom(ctx)
+
+	g.resellerCurrencyMutex.Lock()
+	defer g.resellerCurrencyMutex.Unlock()
+
+	if iso, ok := g.resellerCurrencies[resellerID]; ok {
+		return iso, nil
+	}
+
+	iso, err := fetchResellerCurrency(ctx, g.core)
+	if err != nil {
+		return "", err
+	}
+	if g.resellerCurrencies == nil {
+		g.resellerCurrencies = map[string]CurrencyISO{}
+	}
+	g.resellerCurrencies[resellerID] = iso
+
+	return iso, nil
+}
+
+// SetExchangeRate sets the amount in the given currency worth one unit of the reseller's
+// selling currency. LogicBoxes does not publish exchange rates, so they are supplied by the caller.
+func (g *general) SetExchangeRate(iso CurrencyISO, rate float64) error {
+	if !(rate > 0) || math.IsInf(rate, 1) {
+		return core.NewValidationError("exchange rate must be greater than 0")
+	}
+
+	g.ratesMutex.Lock()
+	defer g.ratesMutex.Unlock()
+	g.rates[iso] = rate
+
+	return nil
+}
+
+func (g *general) ExchangeRate(iso CurrencyISO) (float64, bool) {
+	g.ratesMutex.RLock()
+	defer g.ratesMutex.RUnlock()
+	rate, ok := g.rates[iso]
+	return rate, ok
 }
 
 func (g *general) CountryName(iso CountryISO) string {
@@ -31,6 +87,10 @@ func (g *general) StatesOf(ctx context.Context, iso CountryISO) (States, error)
 	return fetchStateList(ctx, g.core, iso)
 }
 
+func (g *general) SupportedLanguages(ctx context.Context) ([]Language, error) {
+	return fetchLanguages(ctx, g.core)
+}
+
 func New(ctx context.Context, c core.Core) (General, error) {
 	curr, err := fetchCurrencyDB(ctx, c)
 	if err != nil {
@@ -44,5 +104,6 @@ func New(ctx context.Context, c core.Core) (General, error) {
 		core:       c,
 		currencies: curr,
 		countries:  cntrs,
+		rates:      map[CurrencyISO]float64{},
 	}, nil
 }
@@ -0,0 +1,100 @@
+package general
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/internal/coretest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResellerCurrency(t *testing.T) {
+	c, transport := coretest.New(core.Config{StrictDecoding: true}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"sellingcurrencysymbol":"usd","sellingcurrencybalance":"120.50","lockedbalance":"0.00"}`
+	})
+	g := &general{core: c}
+
+	iso, err := g.ResellerCurrency(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, IsoUSD, iso)
+	require.Len(t, transport.Calls("billing/reseller-balance"), 1)
+
+	iso, err = g.ResellerCurrency(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, IsoUSD, iso)
+	require.Len(t, transport.Calls("billing/reseller-balance"), 1)
+}
+
+func TestResellerCurrencyScoped(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(_ string, data url.Values) (int, string) {
+		if data.Get("reseller-id") == "678" {
+			return http.StatusOK, `{"sellingcurrencysymbol":"EUR"}`
+		}
+		return http.StatusOK, `{"sellingcurrencysymbol":"USD"}`
+	})
+	g := &general{core: c}
+
+	iso, err := g.ResellerCurrency(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, IsoUSD, iso)
+
+	ctx := core.WithReseller(context.Background(), "678")
+	iso, err = g.ResellerCurrency(ctx)
+	require.NoError(t, err)
+	require.Equal(t, IsoEUR, iso)
+
+	iso, err = g.ResellerCurrency(ctx)
+	require.NoError(t, err)
+	require.Equal(t, IsoEUR, iso)
+
+	calls := transport.Calls("billing/reseller-balance")
+	require.Len(t, calls, 2)
+	require.False(t, calls[0].Has("reseller-id"))
+	require.Equal(t, "678", calls[1].Get("reseller-id"))
+}
+
+func TestSetExchangeRate(t *testing.T) {
+	g := &general{rates: map[CurrencyISO]float64{}}
+
+	require.NoError(t, g.SetExchangeRate(IsoEUR, 0.92))
+	rate, ok := g.ExchangeRate(IsoEUR)
+	require.True(t, ok)
+	require.InDelta(t, 0.92, rate, 0.0001)
+
+	var validationErr *core.ValidationError
+	require.ErrorAs(t, g.SetExchangeRate(IsoGBP, 0), &validationErr)
+	_, ok = g.ExchangeRate(IsoGBP)
+	require.False(t, ok)
+}
+
+func TestSupportedLanguages(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"fr":"French","en":"English","de":"German"}`
+	})
+	g := &general{core: c}
+
+	languages, err := g.SupportedLanguages(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []Language{
+		{Code: "de", Name: "German"},
+		{Code: "en", Name: "English"},
+		{Code: "fr", Name: "French"},
+	}, languages)
+	require.Len(t, transport.Calls("language/list"), 1)
+
+	c, _ = coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `["en","fr"]`
+	})
+	_, err = (&general{core: c}).SupportedLanguages(context.Background())
+	var apiErr *core.APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusOK, apiErr.StatusCode)
+
+	c, _ = coretest.New(core.Config{}, nil)
+	_, err = (&general{core: c}).SupportedLanguages(context.Background())
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
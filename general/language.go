@@ -0,0 +1,49 @@
+package general
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+)
+
+// Language is a language accepted as lang-pref of customers.
+type Language struct {
+	Code string
+	Name string
+}
+
+func fetchLanguages(ctx context.Context, c core.Core) ([]Language, error) {
+	resp, err := c.CallAPI(ctx, http.MethodGet, "language", "list", url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	keyPairs := map[string]string{}
+	if err := c.Unmarshal(bytesResp, &keyPairs); err != nil {
+		return nil, err
+	}
+
+	languages := make([]Language, 0, len(keyPairs))
+	for code, name := range keyPairs {
+		languages = append(languages, Language{Code: code, Name: name})
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		return languages[i].Code < languages[j].Code
+	})
+
+	return languages, nil
+}
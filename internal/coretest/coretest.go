@@ -0,0 +1,63 @@
+// Package coretest answers the calls of a core.Core from a handler, for tests which run without LogicBoxes.
+package coretest
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+)
+
+// Handler answers a call to api, e.g. "domains/search", with a status code and a body.
+type Handler func(api string, data url.Values) (int, string)
+
+// Transport is an http.RoundTripper answering the calls with its Handler, and recording them.
+type Transport struct {
+	handler Handler
+
+	mutex sync.Mutex
+	calls map[string][]url.Values
+}
+
+// New returns a core.Core built from cfg whose calls are answered by handler, along with its Transport.
+// The calls go through core.CallAPI as they would against LogicBoxes. A nil handler answers with a 404.
+func New(cfg core.Config, handler Handler) (core.Core, *Transport) {
+	transport := &Transport{
+		handler: handler,
+		calls:   map[string][]url.Values{},
+	}
+
+	return core.New(cfg, &http.Client{Transport: transport}), transport
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	api := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/api/"), ".json")
+	data := req.URL.Query()
+
+	t.mutex.Lock()
+	t.calls[api] = append(t.calls[api], data)
+	t.mutex.Unlock()
+
+	statusCode, body := http.StatusNotFound, `{"status":"ERROR","message":"unexpected call"}`
+	if t.handler != nil {
+		statusCode, body = t.handler(api, data)
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// Calls returns the parameters of the calls made to api, in the order they were made.
+func (t *Transport) Calls(api string) []url.Values {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.calls[api]
+}
@@ -4,20 +4,28 @@ package pricing
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"io"
+	"math"
 	"net/http"
 	"net/url"
-	"strings"
+	"sort"
 
 	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/general"
 )
 
 type Pricing interface {
 	GettingCustomerPricing(ctx context.Context, customerID string) (CustomerPrice, error)
+	GettingCustomerPricingInCurrency(
+		ctx context.Context,
+		customerID string,
+		target general.CurrencyISO,
+		g general.General,
+	) (CustomerPrice, error)
 	GettingResellerPricing(ctx context.Context, resellerID string) (ResellerPrice, error)
 	GettingResellerCostPricing(ctx context.Context, resellerID string) (ResellerCostPrice, error)
 	GettingPromoPrices(ctx context.Context) (PromoPrice, error)
+	GettingCustomerProducts(ctx context.Context, customerID string) ([]ProductEntitlement, error)
 }
 
 func New(c core.Core) Pricing {
@@ -44,24 +52,69 @@ func (p *pricing) GettingCustomerPricing(ctx context.Context, customerID string)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result CustomerPrice
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := p.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
 	return result, nil
 }
 
+// GettingCustomerPricingInCurrency converts the customer pricing, which is in the reseller's
+// selling currency, to the target currency using the exchange rates set on g. The pricing is
+// returned unchanged when the target is the selling currency.
+func (p *pricing) GettingCustomerPricingInCurrency(
+	ctx context.Context,
+	customerID string,
+	target general.CurrencyISO,
+	g general.General,
+) (CustomerPrice, error) {
+	if g == nil {
+		return nil, core.NewValidationError("general must not nil")
+	}
+
+	source, err := g.ResellerCurrency(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, ok := g.ExchangeRate(target)
+	if source != target && (!ok || !(rate > 0)) {
+		return nil, core.NewValidationError("missing exchange rate from " + string(source) + " to " + string(target))
+	}
+
+	prices, err := p.GettingCustomerPricing(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if source == target {
+		return prices, nil
+	}
+
+	converted := make(CustomerPrice, len(prices))
+	for productKey, actions := range prices {
+		converted[productKey] = make(map[string]map[string]float64, len(actions))
+		for action, tenures := range actions {
+			converted[productKey][action] = make(map[string]float64, len(tenures))
+			for tenure, price := range tenures {
+				converted[productKey][action][tenure] = math.Round(price*rate*100) / 100
+			}
+		}
+	}
+
+	return converted, nil
+}
+
+// GettingResellerPricing returns the selling prices of the reseller. An empty resellerID falls back to the reseller
+// scope of ctx, see core.WithReseller, and without one to the authenticated reseller.
 func (p *pricing) GettingResellerPricing(ctx context.Context, resellerID string) (ResellerPrice, error) {
 	data := make(url.Values)
-	data.Add("reseller-id", resellerID)
+	if resellerID != "" {
+		data.Add("reseller-id", resellerID)
+	}
 
 	resp, err := p.core.CallAPI(ctx, http.MethodGet, "products", "reseller-price", data)
 	if err != nil {
@@ -75,24 +128,24 @@ func (p *pricing) GettingResellerPricing(ctx context.Context, resellerID string)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result ResellerPrice
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := p.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
 	return result, nil
 }
 
+// GettingResellerCostPricing returns the cost prices of the reseller. An empty resellerID falls back to the reseller
+// scope of ctx, see core.WithReseller, and without one to the authenticated reseller.
 func (p *pricing) GettingResellerCostPricing(ctx context.Context, resellerID string) (ResellerCostPrice, error) {
 	data := make(url.Values)
-	data.Add("reseller-id", resellerID)
+	if resellerID != "" {
+		data.Add("reseller-id", resellerID)
+	}
 
 	resp, err := p.core.CallAPI(ctx, http.MethodGet, "products", "reseller-cost-price", data)
 	if err != nil {
@@ -106,15 +159,11 @@ func (p *pricing) GettingResellerCostPricing(ctx context.Context, resellerID str
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result ResellerCostPrice
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := p.core.Unmarshal(bytesResp, &result); err != nil {
 		return nil, err
 	}
 
@@ -136,17 +185,71 @@ func (p *pricing) GettingPromoPrices(ctx context.Context) (PromoPrice, error) {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		errResponse := core.JSONStatusResponse{}
-		if err := json.Unmarshal(bytesResp, &errResponse); err != nil {
-			return nil, err
-		}
-		return nil, errors.New(strings.ToLower(errResponse.Message))
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
 	}
 
 	var result PromoPrice
-	if err := json.Unmarshal(bytesResp, &result); err != nil {
+	if err := p.core.Unmarshal(bytesResp, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GettingCustomerProducts lists the products of the reseller with whether the customer can purchase them.
+// LogicBoxes exposes no per-customer entitlement, so IsEnabled is a heuristic: a product is reported as
+// enabled when it is priced in the customer's pricing, which LogicBoxes omits products from when they are
+// not sold to the customer. It is not an authoritative permission check.
+func (p *pricing) GettingCustomerProducts(ctx context.Context, customerID string) ([]ProductEntitlement, error) {
+	if !core.RgxNumber.MatchString(customerID) {
+		return nil, core.ErrRcInvalidCredential
+	}
+
+	resp, err := p.core.CallAPI(ctx, http.MethodGet, "products", "category-keys-mapping", url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bytesResp, err := io.ReadAll(resp.Body)
+	if err != nil {
 		return nil, err
 	}
 
+	if resp.StatusCode != http.StatusOK {
+		return nil, core.NewAPIError(resp.StatusCode, bytesResp)
+	}
+
+	var categories map[string][]map[string]json.RawMessage
+	if err := p.core.Unmarshal(bytesResp, &categories); err != nil {
+		return nil, err
+	}
+
+	prices, err := p.GettingCustomerPricing(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ProductEntitlement, 0)
+	for category, products := range categories {
+		for _, product := range products {
+			for productKey := range product {
+				_, enabled := prices[productKey]
+				result = append(result, ProductEntitlement{
+					ProductKey: productKey,
+					Category:   category,
+					IsEnabled:  enabled,
+				})
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Category != result[j].Category {
+			return result[i].Category < result[j].Category
+		}
+		return result[i].ProductKey < result[j].ProductKey
+	})
+
 	return result, nil
 }
@@ -0,0 +1,81 @@
+package pricing
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/mrehanabbasi/go-logicboxes/core"
+	"github.com/mrehanabbasi/go-logicboxes/general"
+	"github.com/mrehanabbasi/go-logicboxes/internal/coretest"
+	"github.com/stretchr/testify/require"
+)
+
+type stubGeneral struct {
+	general.General
+	rates map[general.CurrencyISO]float64
+}
+
+func (s *stubGeneral) ExchangeRate(iso general.CurrencyISO) (float64, bool) {
+	rate, ok := s.rates[iso]
+	return rate, ok
+}
+
+func (s *stubGeneral) ResellerCurrency(context.Context) (general.CurrencyISO, error) {
+	return general.IsoUSD, nil
+}
+
+func TestGettingCustomerPricingInCurrency(t *testing.T) {
+	c, _ := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"domcno":{"addnewdomain":{"1":9.99,"2":19.98},"renewdomain":{"1":10.49}}}`
+	})
+	p := New(c)
+	g := &stubGeneral{rates: map[general.CurrencyISO]float64{general.IsoEUR: 0.9137}}
+
+	prices, err := p.GettingCustomerPricingInCurrency(context.Background(), "12345", general.IsoEUR, g)
+	require.NoError(t, err)
+	require.Equal(t, CustomerPrice{
+		"domcno": {
+			"addnewdomain": {"1": 9.13, "2": 18.26},
+			"renewdomain":  {"1": 9.58},
+		},
+	}, prices)
+
+	_, err = p.GettingCustomerPricingInCurrency(context.Background(), "12345", general.IsoGBP, g)
+	require.ErrorAs(t, err, new(*core.ValidationError))
+	require.EqualError(t, err, "missing exchange rate from USD to GBP")
+
+	prices, err = p.GettingCustomerPricingInCurrency(context.Background(), "12345", general.IsoUSD, g)
+	require.NoError(t, err)
+	require.Equal(t, CustomerPrice{
+		"domcno": {
+			"addnewdomain": {"1": 9.99, "2": 19.98},
+			"renewdomain":  {"1": 10.49},
+		},
+	}, prices)
+}
+
+func TestGettingResellerCostPricingScoped(t *testing.T) {
+	c, transport := coretest.New(core.Config{}, func(string, url.Values) (int, string) {
+		return http.StatusOK, `{"domcno":{"addnewdomain":{"1":9.99}}}`
+	})
+	p := New(c)
+	ctx := core.WithReseller(context.Background(), "678")
+
+	_, err := p.GettingResellerCostPricing(ctx, "")
+	require.NoError(t, err)
+	_, err = p.GettingResellerCostPricing(ctx, "12345")
+	require.NoError(t, err)
+	_, err = p.GettingResellerCostPricing(context.Background(), "")
+	require.NoError(t, err)
+
+	calls := transport.Calls("products/reseller-cost-price")
+	require.Len(t, calls, 3)
+	require.Equal(t, []string{"678"}, calls[0]["reseller-id"])
+	require.Equal(t, []string{"12345"}, calls[1]["reseller-id"])
+	require.False(t, calls[2].Has("reseller-id"))
+
+	_, err = p.GettingResellerCostPricing(core.WithReseller(context.Background(), "sub"), "")
+	require.ErrorIs(t, err, core.ErrRcInvalidCredential)
+}
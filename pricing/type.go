@@ -9,3 +9,10 @@ type ResellerPrice map[string]map[string]map[string]map[string]map[string]string
 type ResellerCostPrice map[string]map[string]map[string]core.JSONFloat
 
 type PromoPrice map[string]string
+
+type ProductEntitlement struct {
+	ProductKey string
+	Category   string
+	// IsEnabled is inferred from the customer pricing, see GettingCustomerProducts.
+	IsEnabled bool
+}